@@ -69,33 +69,61 @@ func main() {
 	movieRepo := repository.NewMovieRepository(db)
 	scheduleRepo := repository.NewScheduleRepository(db)
 	ticketRepo := repository.NewTicketRepository(db)
+	cinemaRepo := repository.NewCinemaRepository(db)
 	transRepo := repository.NewTransactionRepository(db)
 	reportRepo := repository.NewReportRepository(db)
 	promoRepo := repository.NewPromoRepository(db)
+	paymentMethodRepo := repository.NewPaymentMethodRepository(db)
+	campaignRepo := repository.NewCampaignRepository(db)
+	pricingRuleRepo := repository.NewPricingRuleRepository(db)
+	ticketTypeRepo := repository.NewTicketTypeRepository(db)
+	calendarRepo := repository.NewCalendarRepository(db)
+	scheduleSeriesRepo := repository.NewScheduleSeriesRepository(db)
 
 	// UseCase
-	authUC := usecase.NewAuthUseCase(userRepo, cfg)
-	studioUC := usecase.NewStudioUseCase(studioRepo)
+	authUC := usecase.NewAuthUseCase(userRepo, cinemaRepo, cfg)
+	studioUC := usecase.NewStudioUseCase(studioRepo, cinemaRepo, cfg)
 	movieUC := usecase.NewMovieUseCase(movieRepo)
-	scheduleUC := usecase.NewScheduleUseCase(scheduleRepo, movieRepo, studioRepo)
-	ticketUC := usecase.NewTicketUseCase(ticketRepo, scheduleRepo, studioRepo, promoRepo)
-	transUC := usecase.NewTransactionUseCase(transRepo, mailService)
+	scheduleUC := usecase.NewScheduleUseCase(scheduleRepo, movieRepo, studioRepo, ticketRepo, transRepo, cinemaRepo, mailService, cfg)
+	ticketUC := usecase.NewTicketUseCase(ticketRepo, scheduleRepo, studioRepo, promoRepo, paymentMethodRepo, campaignRepo, pricingRuleRepo, ticketTypeRepo, calendarRepo, cfg)
+	transUC := usecase.NewTransactionUseCase(transRepo, paymentMethodRepo, mailService)
 	reportUC := usecase.NewReportUseCase(reportRepo)
 	promoUC := usecase.NewPromoUseCase(promoRepo)
+	paymentMethodUC := usecase.NewPaymentMethodUseCase(paymentMethodRepo, transRepo, cinemaRepo)
+	campaignUC := usecase.NewCampaignUseCase(campaignRepo, promoRepo)
+	pricingRuleUC := usecase.NewPricingRuleUseCase(pricingRuleRepo, scheduleRepo, ticketRepo, calendarRepo)
+	ticketTypeUC := usecase.NewTicketTypeUseCase(ticketTypeRepo)
+	calendarUC := usecase.NewCalendarUseCase(calendarRepo)
+	scheduleSeriesUC := usecase.NewScheduleSeriesUseCase(scheduleSeriesRepo, scheduleRepo, movieRepo, studioRepo, ticketRepo, cfg)
+	scheduleImportUC := usecase.NewScheduleImportUseCase(scheduleRepo, movieRepo, studioRepo, cfg)
+	schedulePlannerUC := usecase.NewSchedulePlannerUseCase(scheduleRepo, movieRepo, studioRepo, scheduleUC, cfg)
+	cinemaUC := usecase.NewCinemaUseCase(cinemaRepo, cfg)
+	calendarFeedUC := usecase.NewCalendarFeedUseCase(scheduleRepo, movieRepo, studioRepo, ticketRepo, userRepo, cinemaRepo, cfg)
+	adminScopeUC := usecase.NewAdminScopeUseCase(userRepo, studioRepo, scheduleRepo, scheduleSeriesRepo, transRepo)
 
 	// Handler
 	authHandler := handler.NewAuthHandler(authUC, val)
 	studioHandler := handler.NewStudioHandler(studioUC, val)
 	movieHandler := handler.NewMovieHandler(movieUC, val)
-	scheduleHandler := handler.NewScheduleHandler(scheduleUC, val)
-	ticketHandler := handler.NewTicketHandler(ticketUC, val)
+	scheduleHandler := handler.NewScheduleHandler(scheduleUC, adminScopeUC, val)
+	ticketHandler := handler.NewTicketHandler(ticketUC, adminScopeUC, val)
 	transHandler := handler.NewTransactionHandler(transUC, val)
 	reportHandler := handler.NewReportHandler(reportUC)
 	promoHandler := handler.NewPromoHandler(promoUC)
+	paymentMethodHandler := handler.NewPaymentMethodHandler(paymentMethodUC, val)
+	campaignHandler := handler.NewCampaignHandler(campaignUC, val)
+	pricingRuleHandler := handler.NewPricingRuleHandler(pricingRuleUC, val)
+	ticketTypeHandler := handler.NewTicketTypeHandler(ticketTypeUC, val)
+	calendarHandler := handler.NewCalendarHandler(calendarUC, val)
+	scheduleSeriesHandler := handler.NewScheduleSeriesHandler(scheduleSeriesUC, adminScopeUC, val)
+	scheduleImportHandler := handler.NewScheduleImportHandler(scheduleImportUC)
+	schedulePlannerHandler := handler.NewSchedulePlannerHandler(schedulePlannerUC, adminScopeUC, val)
+	calendarFeedHandler := handler.NewCalendarFeedHandler(calendarFeedUC)
+	cinemaHandler := handler.NewCinemaHandler(cinemaUC, val)
 
 	// 5. Worker / Scheduler Initialization
 	// Logic background job dipisah ke package worker agar main.go bersih
-	bgWorker := worker.NewScheduler(transUC)
+	bgWorker := worker.NewScheduler(transUC, pricingRuleUC)
 	logger.Log.Info("Starting background scheduler...")
 	bgWorker.Start()
 
@@ -116,7 +144,7 @@ func main() {
 		api.GET("/health", func(c *gin.Context) {
 			c.JSON(200, gin.H{"status": "UP"})
 		})
-		route.SetupRoutes(api, authHandler, studioHandler, movieHandler, scheduleHandler, ticketHandler, transHandler, reportHandler, promoHandler, cfg)
+		route.SetupRoutes(api, authHandler, studioHandler, movieHandler, scheduleHandler, ticketHandler, transHandler, reportHandler, promoHandler, paymentMethodHandler, campaignHandler, pricingRuleHandler, ticketTypeHandler, calendarHandler, scheduleSeriesHandler, scheduleImportHandler, schedulePlannerHandler, calendarFeedHandler, cinemaHandler, adminScopeUC, cfg)
 	}
 
 	// 7. Server Setup
@@ -0,0 +1,41 @@
+// Command superadmin membuat akun super admin pertama (bootstrap) langsung ke database.
+// Admin cabang berikutnya dibuat oleh super admin lewat POST /admins.
+//
+//	go run cmd/superadmin/main.go -name "Owner" -email owner@example.com -password secret123
+package main
+
+import (
+	"flag"
+	"log"
+	"movie-app/internal/config"
+	"movie-app/internal/delivery/http/dto/request"
+	"movie-app/internal/repository"
+	"movie-app/internal/usecase"
+	"movie-app/pkg/database"
+	"movie-app/pkg/validator"
+)
+
+func main() {
+	var req request.RegisterRequest
+	flag.StringVar(&req.Name, "name", "", "Super admin name")
+	flag.StringVar(&req.Email, "email", "", "Super admin email")
+	flag.StringVar(&req.Password, "password", "", "Super admin password (min 6 characters)")
+	flag.Parse()
+
+	if err := validator.NewValidator().Validate(req); err != nil {
+		log.Fatalf("Invalid input: %v", validator.FormatError(err))
+	}
+
+	cfg := config.LoadConfig()
+	db := database.ConnectDB(cfg)
+
+	userRepo := repository.NewUserRepository(db)
+	cinemaRepo := repository.NewCinemaRepository(db)
+	authUC := usecase.NewAuthUseCase(userRepo, cinemaRepo, cfg)
+
+	user, err := authUC.CreateSuperAdmin(req)
+	if err != nil {
+		log.Fatalf("Failed to create super admin: %v", err)
+	}
+	log.Printf("Super admin %s (%s) created", user.Email, user.ID)
+}
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_transactions_status_expires_at;
+
+ALTER TABLE transactions
+DROP COLUMN IF EXISTS expires_at,
+DROP COLUMN IF EXISTS sales_channel;
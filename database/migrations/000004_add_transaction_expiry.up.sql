@@ -0,0 +1,10 @@
+ALTER TABLE transactions
+ADD COLUMN sales_channel VARCHAR(20) DEFAULT 'web',
+ADD COLUMN expires_at TIMESTAMP;
+
+-- Backfill transaksi lama dengan aturan lama (15 menit dari created_at)
+UPDATE transactions SET expires_at = created_at + INTERVAL '15 minutes' WHERE expires_at IS NULL;
+
+ALTER TABLE transactions ALTER COLUMN expires_at SET NOT NULL;
+
+CREATE INDEX idx_transactions_status_expires_at ON transactions (status, expires_at);
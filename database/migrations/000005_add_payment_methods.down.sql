@@ -0,0 +1,4 @@
+ALTER TABLE transactions DROP COLUMN IF EXISTS payment_fee;
+
+DROP TABLE IF EXISTS payment_method_studios;
+DROP TABLE IF EXISTS payment_methods;
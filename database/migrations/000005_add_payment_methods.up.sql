@@ -0,0 +1,34 @@
+CREATE TABLE payment_methods (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    code VARCHAR(50) NOT NULL,
+    display_name VARCHAR(100) NOT NULL,
+    icon_url VARCHAR(255),
+    is_enabled BOOLEAN DEFAULT TRUE,
+    min_amount DECIMAL(10, 2) DEFAULT 0,
+    max_amount DECIMAL(10, 2) DEFAULT 0, -- 0 = tanpa batas
+    fee_type VARCHAR(20) DEFAULT 'fixed',
+    fee_value DECIMAL(10, 2) DEFAULT 0,
+    expiry_minutes INT DEFAULT 0, -- 0 = pakai konfigurasi default
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP
+);
+
+-- Kode cukup unik di antara baris yang belum dihapus (soft delete),
+-- agar metode yang pernah dihapus bisa dibuat ulang dengan kode yang sama
+CREATE UNIQUE INDEX idx_payment_methods_code_active ON payment_methods (code) WHERE deleted_at IS NULL;
+
+-- Ketersediaan per lokasi. Metode tanpa baris di sini tersedia di semua studio.
+CREATE TABLE payment_method_studios (
+    payment_method_id UUID NOT NULL REFERENCES payment_methods(id) ON DELETE CASCADE,
+    studio_id UUID NOT NULL REFERENCES studios(id) ON DELETE CASCADE,
+    PRIMARY KEY (payment_method_id, studio_id)
+);
+
+ALTER TABLE transactions ADD COLUMN payment_fee DECIMAL(10, 2) DEFAULT 0;
+
+-- Seed metode pembayaran yang sebelumnya hardcoded
+INSERT INTO payment_methods (code, display_name) VALUES
+    ('credit_card', 'Credit Card'),
+    ('e_wallet', 'E-Wallet'),
+    ('qris', 'QRIS');
@@ -0,0 +1,8 @@
+DROP TABLE IF EXISTS promo_redemptions;
+
+ALTER TABLE promos
+DROP COLUMN IF EXISTS max_discount_amount,
+DROP COLUMN IF EXISTS min_transaction_amount,
+DROP COLUMN IF EXISTS per_user_limit,
+DROP COLUMN IF EXISTS usage_limit,
+DROP COLUMN IF EXISTS valid_from;
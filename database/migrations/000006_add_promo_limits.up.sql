@@ -0,0 +1,29 @@
+ALTER TABLE promos
+ADD COLUMN valid_from TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+ADD COLUMN usage_limit INT DEFAULT 0,                    -- 0 = tanpa batas
+ADD COLUMN per_user_limit INT DEFAULT 0,                 -- 0 = tanpa batas
+ADD COLUMN min_transaction_amount DECIMAL(10, 2) DEFAULT 0,
+ADD COLUMN max_discount_amount DECIMAL(10, 2) DEFAULT 0; -- 0 = tanpa cap (khusus percentage)
+
+-- Promo lama dianggap sudah berlaku sejak dibuat
+UPDATE promos SET valid_from = created_at;
+
+CREATE TABLE promo_redemptions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    promo_id UUID NOT NULL REFERENCES promos(id),
+    user_id UUID NOT NULL REFERENCES users(id),
+    transaction_id UUID NOT NULL REFERENCES transactions(id),
+    discount_amount DECIMAL(10, 2) NOT NULL DEFAULT 0,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP,
+    UNIQUE(transaction_id)
+);
+
+CREATE INDEX idx_promo_redemptions_promo_user ON promo_redemptions (promo_id, user_id);
+
+-- Catat pemakaian promo yang sudah terjadi sebelum tabel ini ada
+INSERT INTO promo_redemptions (promo_id, user_id, transaction_id, discount_amount, created_at, updated_at)
+SELECT promo_id, user_id, id, discount_amount, created_at, updated_at
+FROM transactions
+WHERE promo_id IS NOT NULL AND status != 'cancelled';
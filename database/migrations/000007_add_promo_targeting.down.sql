@@ -0,0 +1,11 @@
+ALTER TABLE promos
+DROP COLUMN IF EXISTS first_time_only,
+DROP COLUMN IF EXISTS seat_categories,
+DROP COLUMN IF EXISTS end_time,
+DROP COLUMN IF EXISTS start_time,
+DROP COLUMN IF EXISTS weekdays,
+DROP COLUMN IF EXISTS studio_ids,
+DROP COLUMN IF EXISTS genres,
+DROP COLUMN IF EXISTS movie_ids;
+
+ALTER TABLE seats DROP COLUMN IF EXISTS category;
@@ -0,0 +1,13 @@
+-- Kategori kursi (dipakai untuk targeting promo & aturan harga)
+ALTER TABLE seats ADD COLUMN category VARCHAR(20) NOT NULL DEFAULT 'regular';
+
+-- Aturan eligibility promo. Array kosong / NULL = tidak dibatasi.
+ALTER TABLE promos
+ADD COLUMN movie_ids TEXT[] DEFAULT '{}',
+ADD COLUMN genres TEXT[] DEFAULT '{}',
+ADD COLUMN studio_ids TEXT[] DEFAULT '{}',
+ADD COLUMN weekdays TEXT[] DEFAULT '{}',
+ADD COLUMN start_time VARCHAR(5), -- Format HH:MM, jam tayang paling awal
+ADD COLUMN end_time VARCHAR(5),   -- Format HH:MM, jam tayang paling akhir (eksklusif)
+ADD COLUMN seat_categories TEXT[] DEFAULT '{}',
+ADD COLUMN first_time_only BOOLEAN DEFAULT FALSE;
@@ -0,0 +1,4 @@
+ALTER TABLE transactions DROP COLUMN IF EXISTS voucher_code_id;
+
+DROP TABLE IF EXISTS voucher_codes;
+DROP TABLE IF EXISTS campaigns;
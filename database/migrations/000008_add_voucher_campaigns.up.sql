@@ -0,0 +1,27 @@
+CREATE TABLE campaigns (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    name VARCHAR(150) NOT NULL,
+    partner VARCHAR(150),
+    description TEXT,
+    promo_id UUID NOT NULL REFERENCES promos(id),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP
+);
+
+CREATE TABLE voucher_codes (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    campaign_id UUID NOT NULL REFERENCES campaigns(id),
+    code VARCHAR(50) UNIQUE NOT NULL,
+    redeemed_by UUID REFERENCES users(id),
+    redeemed_at TIMESTAMP,
+    transaction_id UUID REFERENCES transactions(id),
+    revoked_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP
+);
+
+CREATE INDEX idx_voucher_codes_campaign_id ON voucher_codes (campaign_id);
+
+ALTER TABLE transactions ADD COLUMN voucher_code_id UUID REFERENCES voucher_codes(id);
@@ -0,0 +1,11 @@
+-- Gagal jika kursi yang sudah dilepas telah dibooking ulang (hapus tiket released terlebih dahulu)
+DROP INDEX IF EXISTS idx_tickets_active_seat;
+ALTER TABLE tickets ADD CONSTRAINT tickets_schedule_id_seat_id_key UNIQUE (schedule_id, seat_id);
+
+ALTER TABLE tickets DROP COLUMN IF EXISTS released_at;
+
+DROP INDEX IF EXISTS idx_promo_redemptions_status;
+
+ALTER TABLE promo_redemptions
+DROP COLUMN IF EXISTS released_at,
+DROP COLUMN IF EXISTS status;
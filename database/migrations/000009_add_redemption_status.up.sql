@@ -0,0 +1,19 @@
+ALTER TABLE promo_redemptions
+ADD COLUMN status VARCHAR(20) NOT NULL DEFAULT 'reserved', -- reserved, consumed, released
+ADD COLUMN released_at TIMESTAMP;
+
+UPDATE promo_redemptions SET status = 'consumed'
+WHERE transaction_id IN (SELECT id FROM transactions WHERE status = 'paid');
+
+CREATE INDEX idx_promo_redemptions_status ON promo_redemptions (promo_id, status);
+
+-- Kursi dari transaksi batal / refund ditandai released_at agar bisa dibooking ulang.
+-- Baris tiket tetap disimpan untuk riwayat; keunikan kursi hanya berlaku untuk tiket aktif.
+ALTER TABLE tickets ADD COLUMN released_at TIMESTAMP;
+
+UPDATE tickets SET released_at = t.updated_at
+FROM transactions t
+WHERE t.id = tickets.transaction_id AND t.status IN ('cancelled', 'refunded');
+
+ALTER TABLE tickets DROP CONSTRAINT IF EXISTS tickets_schedule_id_seat_id_key;
+CREATE UNIQUE INDEX idx_tickets_active_seat ON tickets (schedule_id, seat_id) WHERE released_at IS NULL;
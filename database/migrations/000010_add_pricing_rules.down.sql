@@ -0,0 +1,3 @@
+ALTER TABLE schedules DROP COLUMN IF EXISTS legacy_pricing;
+
+DROP TABLE IF EXISTS pricing_rules;
@@ -0,0 +1,36 @@
+CREATE TABLE pricing_rules (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    name VARCHAR(100) NOT NULL,
+    description TEXT,
+    priority INT NOT NULL DEFAULT 0, -- Angka lebih besar dievaluasi lebih dulu
+    is_active BOOLEAN DEFAULT TRUE,
+
+    -- Kondisi. Array kosong / NULL = tidak dibatasi.
+    weekdays TEXT[] DEFAULT '{}',
+    start_time VARCHAR(5), -- Format HH:MM, jam tayang paling awal
+    end_time VARCHAR(5),   -- Format HH:MM, jam tayang paling akhir (eksklusif)
+    movie_ids TEXT[] DEFAULT '{}',
+    seat_categories TEXT[] DEFAULT '{}',
+
+    adjustment_type VARCHAR(20) NOT NULL DEFAULT 'fixed',
+    adjustment_value DECIMAL(10, 2) NOT NULL DEFAULT 0,
+    stop_processing BOOLEAN DEFAULT FALSE, -- Rule berikutnya tidak dievaluasi jika rule ini cocok
+
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP
+);
+
+-- Seed aturan yang sebelumnya hardcoded di scheduleUseCase.Create:
+-- +10.000 sekali saja jika weekend ATAU jam tayang >= 17:00
+INSERT INTO pricing_rules (name, description, priority, weekdays, adjustment_type, adjustment_value, stop_processing) VALUES
+    ('Weekend surcharge', 'Tambahan harga untuk jadwal Sabtu & Minggu', 20, '{saturday,sunday}', 'fixed', 10000, TRUE);
+
+INSERT INTO pricing_rules (name, description, priority, start_time, end_time, adjustment_type, adjustment_value) VALUES
+    ('Prime time surcharge', 'Tambahan harga untuk jam tayang mulai 17:00', 10, '17:00', '00:00', 'fixed', 10000);
+
+-- Harga jadwal lama tidak diubah: tidak bisa dipastikan mana yang sudah termasuk surcharge
+-- (Create menambahkannya, Update tidak). Jadwal lama ditandai legacy_pricing sehingga kolom price
+-- tetap dipakai apa adanya tanpa pricing rules, sampai admin mengisi ulang harganya.
+ALTER TABLE schedules ADD COLUMN legacy_pricing BOOLEAN NOT NULL DEFAULT FALSE;
+UPDATE schedules SET legacy_pricing = TRUE;
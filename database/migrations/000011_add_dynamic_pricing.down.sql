@@ -0,0 +1,12 @@
+DROP TABLE IF EXISTS schedule_price_histories;
+
+ALTER TABLE tickets DROP COLUMN IF EXISTS price;
+
+ALTER TABLE schedules
+DROP COLUMN IF EXISTS min_price,
+DROP COLUMN IF EXISTS max_price;
+
+ALTER TABLE pricing_rules
+DROP COLUMN IF EXISTS min_occupancy,
+DROP COLUMN IF EXISTS max_occupancy,
+DROP COLUMN IF EXISTS within_hours;
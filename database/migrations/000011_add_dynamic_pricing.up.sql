@@ -0,0 +1,34 @@
+-- Kondisi demand untuk pricing rules (0 = tidak dibatasi)
+ALTER TABLE pricing_rules
+ADD COLUMN min_occupancy DECIMAL(5, 2) DEFAULT 0, -- Cocok jika okupansi (%) >= nilai ini
+ADD COLUMN max_occupancy DECIMAL(5, 2) DEFAULT 0, -- Cocok jika okupansi (%) < nilai ini
+ADD COLUMN within_hours INT DEFAULT 0;            -- Cocok jika jadwal mulai dalam N jam ke depan
+
+-- Batas bawah & atas harga per kursi (0 = tanpa batas)
+ALTER TABLE schedules
+ADD COLUMN min_price DECIMAL(10, 2) DEFAULT 0,
+ADD COLUMN max_price DECIMAL(10, 2) DEFAULT 0;
+
+-- Harga dikunci per tiket saat booking
+ALTER TABLE tickets ADD COLUMN price DECIMAL(10, 2) NOT NULL DEFAULT 0;
+
+-- Backfill: subtotal transaksi dibagi rata ke tiketnya (harga yang benar-benar dibayar sebelum diskon)
+UPDATE tickets SET price = t.total_amount / c.ticket_count
+FROM transactions t,
+     (SELECT transaction_id, COUNT(*) AS ticket_count FROM tickets GROUP BY transaction_id) c
+WHERE t.id = tickets.transaction_id AND c.transaction_id = tickets.transaction_id;
+
+CREATE TABLE schedule_price_histories (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    schedule_id UUID NOT NULL REFERENCES schedules(id) ON DELETE CASCADE,
+    seat_category VARCHAR(20), -- Kosong = harga dasar jadwal
+    old_price DECIMAL(10, 2) DEFAULT 0,
+    new_price DECIMAL(10, 2) NOT NULL,
+    reason VARCHAR(20) NOT NULL, -- created, manual, dynamic
+    occupancy DECIMAL(5, 2) DEFAULT 0,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP
+);
+
+CREATE INDEX idx_schedule_price_histories_schedule ON schedule_price_histories (schedule_id, seat_category, created_at);
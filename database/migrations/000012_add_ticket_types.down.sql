@@ -0,0 +1,5 @@
+ALTER TABLE tickets
+DROP COLUMN IF EXISTS ticket_type_id,
+DROP COLUMN IF EXISTS ticket_type_code;
+
+DROP TABLE IF EXISTS ticket_types;
@@ -0,0 +1,26 @@
+CREATE TABLE ticket_types (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    code VARCHAR(30) UNIQUE NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    description TEXT,
+    adjustment_type VARCHAR(20) NOT NULL DEFAULT 'percentage',
+    adjustment_value DECIMAL(10, 2) NOT NULL DEFAULT 0, -- Negatif = potongan dari harga kursi
+    requirement VARCHAR(255), -- Syarat yang dicek saat check-in, mis. kartu pelajar
+    is_active BOOLEAN DEFAULT TRUE,
+    sort_order INT DEFAULT 0,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP
+);
+
+INSERT INTO ticket_types (code, name, adjustment_type, adjustment_value, requirement, sort_order) VALUES
+    ('adult', 'Adult', 'percentage', 0, NULL, 1),
+    ('child', 'Child', 'percentage', -20, 'Under 12 years old', 2),
+    ('student', 'Student', 'percentage', -15, 'Show a valid student ID at check-in', 3),
+    ('senior', 'Senior', 'percentage', -25, 'Show an ID proving age 60+ at check-in', 4);
+
+ALTER TABLE tickets
+ADD COLUMN ticket_type_id UUID REFERENCES ticket_types(id),
+ADD COLUMN ticket_type_code VARCHAR(30) NOT NULL DEFAULT 'adult'; -- Snapshot kode saat booking
+
+UPDATE tickets SET ticket_type_id = (SELECT id FROM ticket_types WHERE code = 'adult');
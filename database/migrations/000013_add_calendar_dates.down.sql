@@ -0,0 +1,4 @@
+ALTER TABLE promos DROP COLUMN IF EXISTS day_types;
+ALTER TABLE pricing_rules DROP COLUMN IF EXISTS day_types;
+
+DROP TABLE IF EXISTS calendar_dates;
@@ -0,0 +1,21 @@
+CREATE TABLE calendar_dates (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    name VARCHAR(150) NOT NULL,
+    date DATE NOT NULL,
+    day_type VARCHAR(20) NOT NULL DEFAULT 'holiday', -- holiday, special_event
+    is_recurring BOOLEAN DEFAULT FALSE, -- Berulang tiap tahun di tanggal & bulan yang sama
+    description TEXT,
+    source VARCHAR(20) NOT NULL DEFAULT 'manual', -- manual, ical
+    external_uid VARCHAR(255), -- UID event iCalendar, agar import ulang tidak duplikat
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP
+);
+
+CREATE INDEX idx_calendar_dates_date ON calendar_dates (date);
+CREATE INDEX idx_calendar_dates_external_uid ON calendar_dates (external_uid, date);
+
+-- Kondisi jenis hari untuk pricing rules & promo. Array kosong = tidak dibatasi.
+-- Nilai: holiday, special_event, regular (hari tanpa entri kalender)
+ALTER TABLE pricing_rules ADD COLUMN day_types TEXT[] DEFAULT '{}';
+ALTER TABLE promos ADD COLUMN day_types TEXT[] DEFAULT '{}';
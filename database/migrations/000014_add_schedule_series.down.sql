@@ -0,0 +1,3 @@
+ALTER TABLE schedules DROP COLUMN IF EXISTS series_id;
+
+DROP TABLE IF EXISTS schedule_series;
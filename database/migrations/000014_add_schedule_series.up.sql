@@ -0,0 +1,19 @@
+CREATE TABLE schedule_series (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    studio_id UUID NOT NULL REFERENCES studios(id),
+    movie_id UUID NOT NULL REFERENCES movies(id),
+    start_date DATE NOT NULL,
+    end_date DATE NOT NULL,
+    weekdays TEXT[] DEFAULT '{}',    -- Kosong = setiap hari
+    start_times TEXT[] NOT NULL,     -- Jam tayang harian, format HH:MM
+    price DECIMAL(10, 2) NOT NULL,
+    min_price DECIMAL(10, 2) DEFAULT 0,
+    max_price DECIMAL(10, 2) DEFAULT 0,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP
+);
+
+ALTER TABLE schedules ADD COLUMN series_id UUID REFERENCES schedule_series(id);
+
+CREATE INDEX idx_schedules_series_id ON schedules (series_id);
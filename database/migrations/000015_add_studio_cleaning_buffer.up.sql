@@ -0,0 +1,2 @@
+-- Jeda bersih-bersih setelah film selesai sebelum jadwal berikutnya boleh mulai
+ALTER TABLE studios ADD COLUMN cleaning_minutes INT NOT NULL DEFAULT 15;
@@ -0,0 +1,11 @@
+DROP TABLE IF EXISTS user_credits;
+
+ALTER TABLE users DROP COLUMN IF EXISTS credit_balance;
+
+DROP INDEX IF EXISTS idx_schedules_status;
+
+ALTER TABLE schedules
+    DROP COLUMN IF EXISTS replacement_schedule_id,
+    DROP COLUMN IF EXISTS cancelled_at,
+    DROP COLUMN IF EXISTS cancellation_reason,
+    DROP COLUMN IF EXISTS status;
@@ -0,0 +1,24 @@
+ALTER TABLE schedules
+    ADD COLUMN status VARCHAR(20) NOT NULL DEFAULT 'active',
+    ADD COLUMN cancellation_reason TEXT,
+    ADD COLUMN cancelled_at TIMESTAMP,
+    ADD COLUMN replacement_schedule_id UUID REFERENCES schedules(id);
+
+CREATE INDEX idx_schedules_status ON schedules (status);
+
+-- Saldo kredit user (mis. kompensasi jadwal yang dibatalkan)
+ALTER TABLE users ADD COLUMN credit_balance DECIMAL(10, 2) NOT NULL DEFAULT 0;
+
+-- Riwayat mutasi kredit
+CREATE TABLE user_credits (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id),
+    transaction_id UUID REFERENCES transactions(id),
+    amount DECIMAL(10, 2) NOT NULL, -- Positif = masuk, negatif = dipakai
+    reason TEXT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP
+);
+
+CREATE INDEX idx_user_credits_user_id ON user_credits (user_id);
@@ -0,0 +1,24 @@
+ALTER TABLE tickets
+    ALTER COLUMN released_at TYPE TIMESTAMP USING released_at AT TIME ZONE 'Asia/Jakarta';
+
+ALTER TABLE voucher_codes
+    ALTER COLUMN revoked_at TYPE TIMESTAMP USING revoked_at AT TIME ZONE 'Asia/Jakarta',
+    ALTER COLUMN redeemed_at TYPE TIMESTAMP USING redeemed_at AT TIME ZONE 'Asia/Jakarta';
+
+ALTER TABLE promo_redemptions
+    ALTER COLUMN released_at TYPE TIMESTAMP USING released_at AT TIME ZONE 'Asia/Jakarta';
+
+ALTER TABLE promos
+    ALTER COLUMN valid_until TYPE TIMESTAMP USING valid_until AT TIME ZONE 'Asia/Jakarta',
+    ALTER COLUMN valid_from TYPE TIMESTAMP USING valid_from AT TIME ZONE 'Asia/Jakarta';
+
+ALTER TABLE transactions
+    ALTER COLUMN expires_at TYPE TIMESTAMP USING expires_at AT TIME ZONE 'Asia/Jakarta',
+    ALTER COLUMN created_at TYPE TIMESTAMP USING created_at AT TIME ZONE 'Asia/Jakarta';
+
+ALTER TABLE schedules
+    ALTER COLUMN cancelled_at TYPE TIMESTAMP USING cancelled_at AT TIME ZONE 'Asia/Jakarta',
+    ALTER COLUMN end_time TYPE TIMESTAMP USING end_time AT TIME ZONE 'Asia/Jakarta',
+    ALTER COLUMN start_time TYPE TIMESTAMP USING start_time AT TIME ZONE 'Asia/Jakarta';
+
+ALTER TABLE studios DROP COLUMN IF EXISTS timezone;
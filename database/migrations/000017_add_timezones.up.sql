@@ -0,0 +1,28 @@
+-- Zona waktu IANA per studio. Semua aturan bisnis (prime time, kalender, laporan harian) dihitung di jam lokal studio.
+ALTER TABLE studios ADD COLUMN timezone VARCHAR(64) NOT NULL DEFAULT 'Asia/Jakarta';
+
+-- Data lama disimpan sebagai jam lokal tanpa zona (TIMESTAMP), diasumsikan Asia/Jakarta (zona default).
+-- Setelah ini disimpan sebagai TIMESTAMPTZ (UTC).
+ALTER TABLE schedules
+    ALTER COLUMN start_time TYPE TIMESTAMPTZ USING start_time AT TIME ZONE 'Asia/Jakarta',
+    ALTER COLUMN end_time TYPE TIMESTAMPTZ USING end_time AT TIME ZONE 'Asia/Jakarta',
+    ALTER COLUMN cancelled_at TYPE TIMESTAMPTZ USING cancelled_at AT TIME ZONE 'Asia/Jakarta';
+
+ALTER TABLE transactions
+    ALTER COLUMN created_at TYPE TIMESTAMPTZ USING created_at AT TIME ZONE 'Asia/Jakarta',
+    ALTER COLUMN expires_at TYPE TIMESTAMPTZ USING expires_at AT TIME ZONE 'Asia/Jakarta';
+
+-- Waktu berlaku promo dibandingkan dengan NOW() & dikirim admin dengan offset: simpan juga sebagai UTC
+ALTER TABLE promos
+    ALTER COLUMN valid_from TYPE TIMESTAMPTZ USING valid_from AT TIME ZONE 'Asia/Jakarta',
+    ALTER COLUMN valid_until TYPE TIMESTAMPTZ USING valid_until AT TIME ZONE 'Asia/Jakarta';
+
+ALTER TABLE promo_redemptions
+    ALTER COLUMN released_at TYPE TIMESTAMPTZ USING released_at AT TIME ZONE 'Asia/Jakarta';
+
+ALTER TABLE voucher_codes
+    ALTER COLUMN redeemed_at TYPE TIMESTAMPTZ USING redeemed_at AT TIME ZONE 'Asia/Jakarta',
+    ALTER COLUMN revoked_at TYPE TIMESTAMPTZ USING revoked_at AT TIME ZONE 'Asia/Jakarta';
+
+ALTER TABLE tickets
+    ALTER COLUMN released_at TYPE TIMESTAMPTZ USING released_at AT TIME ZONE 'Asia/Jakarta';
@@ -0,0 +1,3 @@
+ALTER TABLE schedules
+    DROP COLUMN IF EXISTS sales_close_at,
+    DROP COLUMN IF EXISTS sales_open_at;
@@ -0,0 +1,4 @@
+-- Jendela penjualan per jadwal. NULL = pakai default dari config (SALES_OPEN_DAYS_BEFORE / BOOKING_CUTOFF_MINUTES)
+ALTER TABLE schedules
+    ADD COLUMN sales_open_at TIMESTAMPTZ,
+    ADD COLUMN sales_close_at TIMESTAMPTZ;
@@ -0,0 +1,4 @@
+-- Token rahasia untuk feed kalender tiket user (dipakai aplikasi kalender tanpa login)
+ALTER TABLE users ADD COLUMN calendar_token VARCHAR(64);
+
+CREATE UNIQUE INDEX idx_users_calendar_token ON users (calendar_token);
@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS payment_method_studios (
+    payment_method_id UUID NOT NULL REFERENCES payment_methods(id) ON DELETE CASCADE,
+    studio_id UUID NOT NULL REFERENCES studios(id) ON DELETE CASCADE,
+    PRIMARY KEY (payment_method_id, studio_id)
+);
+
+DROP TABLE IF EXISTS payment_method_cinemas;
+
+DROP INDEX IF EXISTS idx_studios_cinema_id;
+
+ALTER TABLE studios DROP COLUMN IF EXISTS cinema_id;
+
+DROP TABLE IF EXISTS cinemas;
@@ -0,0 +1,40 @@
+-- Cinema (cabang bioskop). Studio berada di bawah 1 cinema.
+CREATE TABLE cinemas (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    name VARCHAR(150) NOT NULL,
+    address TEXT NOT NULL,
+    city VARCHAR(100) NOT NULL,
+    latitude DOUBLE PRECISION NOT NULL,
+    longitude DOUBLE PRECISION NOT NULL,
+    timezone VARCHAR(64) NOT NULL DEFAULT 'Asia/Jakarta',
+    opening_time VARCHAR(5) NOT NULL DEFAULT '10:00', -- HH:MM jam lokal
+    closing_time VARCHAR(5) NOT NULL DEFAULT '00:00', -- Boleh lewat tengah malam
+    phone VARCHAR(30),
+    email VARCHAR(100),
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMPTZ
+);
+
+CREATE INDEX idx_cinemas_city ON cinemas (LOWER(city));
+CREATE INDEX idx_cinemas_deleted_at ON cinemas (deleted_at);
+
+-- Studio lama belum punya cinema (NULL) sampai di-assign admin
+ALTER TABLE studios ADD COLUMN cinema_id UUID REFERENCES cinemas(id);
+
+CREATE INDEX idx_studios_cinema_id ON studios (cinema_id);
+
+-- Ketersediaan metode pembayaran kini per cinema (sebelumnya per studio).
+-- Studio lama belum punya cinema sehingga batasannya tidak bisa dipetakan: metode yang dibatasi
+-- dinonaktifkan agar tidak mendadak tersedia di semua lokasi, lalu diatur ulang admin per cinema.
+CREATE TABLE payment_method_cinemas (
+    payment_method_id UUID NOT NULL REFERENCES payment_methods(id) ON DELETE CASCADE,
+    cinema_id UUID NOT NULL REFERENCES cinemas(id) ON DELETE CASCADE,
+    PRIMARY KEY (payment_method_id, cinema_id)
+);
+
+UPDATE payment_methods SET is_enabled = FALSE
+WHERE id IN (SELECT DISTINCT payment_method_id FROM payment_method_studios);
+
+DROP TABLE payment_method_studios;
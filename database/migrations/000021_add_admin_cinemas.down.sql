@@ -0,0 +1,3 @@
+UPDATE users SET role = 'admin' WHERE role = 'super_admin';
+
+DROP TABLE IF EXISTS admin_cinemas;
@@ -0,0 +1,11 @@
+-- Admin cabang hanya boleh mengelola cinema yang di-assign ke dirinya
+CREATE TABLE admin_cinemas (
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    cinema_id UUID NOT NULL REFERENCES cinemas(id) ON DELETE CASCADE,
+    PRIMARY KEY (user_id, cinema_id)
+);
+
+CREATE INDEX idx_admin_cinemas_cinema_id ON admin_cinemas (cinema_id);
+
+-- Admin yang sudah ada tetap punya akses global
+UPDATE users SET role = 'super_admin' WHERE role = 'admin';
@@ -0,0 +1,14 @@
+ALTER TABLE pricing_rules
+    DROP COLUMN IF EXISTS audio_languages,
+    DROP COLUMN IF EXISTS features,
+    DROP COLUMN IF EXISTS formats;
+
+DROP INDEX IF EXISTS idx_schedules_format;
+
+ALTER TABLE schedules
+    DROP COLUMN IF EXISTS subtitle_language,
+    DROP COLUMN IF EXISTS audio_language,
+    DROP COLUMN IF EXISTS features,
+    DROP COLUMN IF EXISTS format;
+
+ALTER TABLE studios DROP COLUMN IF EXISTS features;
@@ -0,0 +1,17 @@
+-- Kapabilitas studio: 3d, imax, 4dx, dolby_atmos, screenx
+ALTER TABLE studios ADD COLUMN features TEXT[] DEFAULT '{}';
+
+-- Atribut tayang per jadwal. Format 3D & fitur premium harus didukung studio.
+ALTER TABLE schedules
+    ADD COLUMN format VARCHAR(10) NOT NULL DEFAULT '2d',
+    ADD COLUMN features TEXT[] DEFAULT '{}',
+    ADD COLUMN audio_language VARCHAR(35),
+    ADD COLUMN subtitle_language VARCHAR(35);
+
+CREATE INDEX idx_schedules_format ON schedules (format);
+
+-- Kondisi pricing rule berdasarkan atribut tayang (kosong = tidak dibatasi)
+ALTER TABLE pricing_rules
+    ADD COLUMN formats TEXT[] DEFAULT '{}',
+    ADD COLUMN features TEXT[] DEFAULT '{}',
+    ADD COLUMN audio_languages TEXT[] DEFAULT '{}';
@@ -0,0 +1,12 @@
+DROP INDEX IF EXISTS idx_movies_release_date;
+
+ALTER TABLE movies
+    DROP COLUMN IF EXISTS backdrop_url,
+    DROP COLUMN IF EXISTS trailer_url,
+    DROP COLUMN IF EXISTS subtitle_languages,
+    DROP COLUMN IF EXISTS original_language,
+    DROP COLUMN IF EXISTS cast_members,
+    DROP COLUMN IF EXISTS director,
+    DROP COLUMN IF EXISTS end_of_run_date,
+    DROP COLUMN IF EXISTS release_date,
+    DROP COLUMN IF EXISTS age_rating;
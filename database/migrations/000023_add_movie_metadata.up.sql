@@ -0,0 +1,13 @@
+-- Metadata film: klasifikasi usia (LSF), masa tayang, kru, bahasa & media promosi
+ALTER TABLE movies
+    ADD COLUMN age_rating VARCHAR(10),
+    ADD COLUMN release_date DATE,
+    ADD COLUMN end_of_run_date DATE,
+    ADD COLUMN director VARCHAR(255),
+    ADD COLUMN cast_members TEXT[] DEFAULT '{}',
+    ADD COLUMN original_language VARCHAR(35),
+    ADD COLUMN subtitle_languages TEXT[] DEFAULT '{}',
+    ADD COLUMN trailer_url VARCHAR(255),
+    ADD COLUMN backdrop_url VARCHAR(255);
+
+CREATE INDEX idx_movies_release_date ON movies (release_date);
@@ -2,6 +2,9 @@ package config
 
 import (
 	"log"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/spf13/viper"
 )
@@ -29,6 +32,23 @@ type Config struct {
 	SMTPPort int    `mapstructure:"SMTP_PORT"`
 	SMTPUser string `mapstructure:"SMTP_USER"`
 	SMTPPass string `mapstructure:"SMTP_PASS"`
+
+	// Payment Config
+	// Batas waktu per metode pembayaran diatur di katalog (payment_methods.expiry_minutes)
+	PaymentExpiryMinutes   int    `mapstructure:"PAYMENT_EXPIRY_MINUTES"`
+	PaymentExpiryByChannel string `mapstructure:"PAYMENT_EXPIRY_BY_CHANNEL"` // Format: "box_office:5,mobile:15"
+
+	// Hasil parsing PaymentExpiryByChannel (sekali saat LoadConfig)
+	expiryByChannel map[string]int
+
+	// Schedule Config
+	PreShowMinutes int `mapstructure:"PRE_SHOW_MINUTES"` // Iklan & trailer sebelum film, ditambahkan ke end time otomatis
+	// Zona waktu IANA untuk studio baru & filter tanggal tanpa studio
+	DefaultTimezone string `mapstructure:"DEFAULT_TIMEZONE"`
+
+	// Sales Window Default (jika jadwal tidak mengisi sales_open_at / sales_close_at)
+	SalesOpenDaysBefore  int `mapstructure:"SALES_OPEN_DAYS_BEFORE"` // 0 = langsung dijual sejak jadwal dibuat
+	BookingCutoffMinutes int `mapstructure:"BOOKING_CUTOFF_MINUTES"` // Menit setelah start (negatif = sebelum start)
 }
 
 func LoadConfig() *Config {
@@ -37,6 +57,12 @@ func LoadConfig() *Config {
 
 	viper.SetDefault("SMTP_HOST", "localhost")
 	viper.SetDefault("SMTP_PORT", 1025)
+	viper.SetDefault("PAYMENT_EXPIRY_MINUTES", 15)
+	viper.SetDefault("PAYMENT_EXPIRY_BY_CHANNEL", "")
+	viper.SetDefault("PRE_SHOW_MINUTES", 15)
+	viper.SetDefault("DEFAULT_TIMEZONE", "Asia/Jakarta")
+	viper.SetDefault("SALES_OPEN_DAYS_BEFORE", 0)
+	viper.SetDefault("BOOKING_CUTOFF_MINUTES", 15)
 
 	// Jika file .env tidak ditemukan, tidak panic (karena mungkin pakai environment variables asli)
 	if err := viper.ReadInConfig(); err != nil {
@@ -47,5 +73,37 @@ func LoadConfig() *Config {
 	if err := viper.Unmarshal(&config); err != nil {
 		log.Fatalf("Error loading config: %v", err)
 	}
+	config.expiryByChannel = parseMinutesMap(config.PaymentExpiryByChannel)
 	return &config
 }
+
+// PaymentExpiry menentukan berapa lama transaksi pending boleh menunggu pembayaran.
+// Prioritas: override per sales channel (config), lalu batas metode pembayaran
+// (methodMinutes dari katalog, 0 = tidak diatur), lalu PAYMENT_EXPIRY_MINUTES.
+func (c *Config) PaymentExpiry(salesChannel string, methodMinutes int) time.Duration {
+	if minutes, ok := c.expiryByChannel[salesChannel]; ok && salesChannel != "" {
+		return time.Duration(minutes) * time.Minute
+	}
+	if methodMinutes > 0 {
+		return time.Duration(methodMinutes) * time.Minute
+	}
+	return time.Duration(c.PaymentExpiryMinutes) * time.Minute
+}
+
+// parseMinutesMap mengubah string "key:menit,key:menit" menjadi map.
+// Entry yang formatnya salah diabaikan.
+func parseMinutesMap(raw string) map[string]int {
+	result := make(map[string]int)
+	for _, pair := range strings.Split(raw, ",") {
+		parts := strings.SplitN(strings.TrimSpace(pair), ":", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		minutes, err := strconv.Atoi(strings.TrimSpace(parts[1]))
+		if err != nil || minutes <= 0 {
+			continue
+		}
+		result[strings.TrimSpace(parts[0])] = minutes
+	}
+	return result
+}
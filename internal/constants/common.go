@@ -5,6 +5,8 @@ const (
 	CtxUserID = "user_id"
 	CtxRole   = "role"
 	CtxName   = "name"
+	// Cinema yang boleh dikelola admin cabang (dimuat dari database per request)
+	CtxCinemaIDs = "cinema_ids"
 
 	// Headers
 	HeaderAuthorization = "Authorization"
@@ -6,6 +6,11 @@ type RegisterRequest struct {
 	Password string `json:"password" validate:"required,min=6"`
 }
 
+// SetAdminCinemasRequest mengganti cinema yang dikelola admin cabang (kosong = tidak ada akses cabang)
+type SetAdminCinemasRequest struct {
+	CinemaIDs []string `json:"cinema_ids" validate:"dive,uuid"`
+}
+
 type LoginRequest struct {
 	Email    string `json:"email" validate:"required,email"`
 	Password string `json:"password" validate:"required"`
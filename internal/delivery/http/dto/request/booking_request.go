@@ -2,6 +2,17 @@ package request
 
 type BookTicketRequest struct {
 	ScheduleID string   `json:"schedule_id" validate:"required,uuid"`
-	SeatIDs    []string `json:"seat_ids" validate:"required,min=1,dive,uuid"` // Array of Seat UUID
-	PromoCode  string   `json:"promo_code"`
+	SeatIDs    []string `json:"seat_ids" validate:"required_without=Items,dive,uuid"` // Array of Seat UUID, semua tipe adult
+	// Items dipakai untuk memilih tipe tiket per kursi. Jika diisi, seat_ids diabaikan.
+	Items     []BookingItemRequest `json:"items" validate:"omitempty,dive"`
+	PromoCode string               `json:"promo_code"`
+
+	// Optional: dipakai untuk menentukan batas waktu pembayaran
+	PaymentMethod string `json:"payment_method"` // Kode dari katalog payment_methods
+	SalesChannel  string `json:"sales_channel" validate:"omitempty,oneof=web mobile box_office"`
+}
+
+type BookingItemRequest struct {
+	SeatID     string `json:"seat_id" validate:"required,uuid"`
+	TicketType string `json:"ticket_type"` // Kode tipe tiket, default: adult
 }
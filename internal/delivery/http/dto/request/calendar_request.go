@@ -0,0 +1,17 @@
+package request
+
+type CreateCalendarDateRequest struct {
+	Name        string `json:"name" validate:"required,max=150"`
+	Date        string `json:"date" validate:"required,datetime=2006-01-02"`
+	DayType     string `json:"day_type" validate:"required,oneof=holiday special_event"`
+	IsRecurring bool   `json:"is_recurring"` // true = berulang tiap tahun (mis. 17 Agustus)
+	Description string `json:"description"`
+}
+
+type UpdateCalendarDateRequest struct {
+	Name        string  `json:"name" validate:"omitempty,max=150"`
+	Date        string  `json:"date" validate:"omitempty,datetime=2006-01-02"`
+	DayType     string  `json:"day_type" validate:"omitempty,oneof=holiday special_event"`
+	IsRecurring *bool   `json:"is_recurring"`
+	Description *string `json:"description"`
+}
@@ -0,0 +1,18 @@
+package request
+
+type CreateCampaignRequest struct {
+	Name        string `json:"name" validate:"required,max=150"`
+	Partner     string `json:"partner" validate:"omitempty,max=150"`
+	Description string `json:"description"`
+	PromoID     string `json:"promo_id" validate:"required,uuid"` // Definisi diskon & eligibility
+}
+
+type GenerateVoucherCodesRequest struct {
+	Count  int    `json:"count" validate:"required,min=1,max=10000"`
+	Prefix string `json:"prefix" validate:"omitempty,alphanum,max=10"`
+	Length int    `json:"length" validate:"omitempty,min=8,max=32"` // Panjang bagian acak, default 12
+}
+
+type RevokeVoucherCodesRequest struct {
+	Codes []string `json:"codes"` // Kosong = cabut semua kode yang belum dipakai
+}
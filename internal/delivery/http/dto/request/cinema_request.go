@@ -0,0 +1,36 @@
+package request
+
+type CreateCinemaRequest struct {
+	Name        string  `json:"name" validate:"required,max=150"`
+	Address     string  `json:"address" validate:"required"`
+	City        string  `json:"city" validate:"required,max=100"`
+	Latitude    float64 `json:"latitude" validate:"latitude"`
+	Longitude   float64 `json:"longitude" validate:"longitude"`
+	Timezone    string  `json:"timezone" validate:"omitempty,timezone"`           // IANA, default: DEFAULT_TIMEZONE
+	OpeningTime string  `json:"opening_time" validate:"omitempty,datetime=15:04"` // Default: 10:00
+	ClosingTime string  `json:"closing_time" validate:"omitempty,datetime=15:04"` // Default: 00:00 (boleh lewat tengah malam)
+	Phone       string  `json:"phone" validate:"omitempty,max=30"`
+	Email       string  `json:"email" validate:"omitempty,email,max=100"`
+}
+
+type UpdateCinemaRequest struct {
+	Name        string   `json:"name" validate:"omitempty,max=150"`
+	Address     string   `json:"address"`
+	City        string   `json:"city" validate:"omitempty,max=100"`
+	Latitude    *float64 `json:"latitude" validate:"omitempty,latitude"`
+	Longitude   *float64 `json:"longitude" validate:"omitempty,longitude"`
+	Timezone    string   `json:"timezone" validate:"omitempty,timezone"`
+	OpeningTime string   `json:"opening_time" validate:"omitempty,datetime=15:04"`
+	ClosingTime string   `json:"closing_time" validate:"omitempty,datetime=15:04"`
+	Phone       *string  `json:"phone" validate:"omitempty,max=30"`
+	Email       *string  `json:"email" validate:"omitempty,email,max=100"`
+	IsActive    *bool    `json:"is_active"`
+}
+
+// NearbyCinemaRequest adalah query params pencarian cinema terdekat
+type NearbyCinemaRequest struct {
+	Lat      float64 `validate:"latitude"`
+	Lng      float64 `validate:"longitude"`
+	RadiusKm float64 `validate:"omitempty,gt=0,max=500"`  // Default: 25
+	Limit    int     `validate:"omitempty,min=1,max=100"` // Default: 20
+}
@@ -6,6 +6,17 @@ type CreateMovieRequest struct {
 	Duration    int    `json:"duration" validate:"required,min=1"` // Menit
 	Genre       string `json:"genre" validate:"required"`
 	PosterURL   string `json:"poster_url" validate:"required,url"`
+
+	// Metadata (opsional)
+	AgeRating         string   `json:"age_rating" validate:"omitempty,oneof=SU 13+ 17+ 21+"`
+	ReleaseDate       string   `json:"release_date" validate:"omitempty,datetime=2006-01-02"`
+	EndOfRunDate      string   `json:"end_of_run_date" validate:"omitempty,datetime=2006-01-02"`
+	Director          string   `json:"director" validate:"omitempty,max=255"`
+	Cast              []string `json:"cast" validate:"omitempty,dive,required,max=255"`
+	OriginalLanguage  string   `json:"original_language" validate:"omitempty,bcp47_language_tag"`
+	SubtitleLanguages []string `json:"subtitle_languages" validate:"omitempty,dive,bcp47_language_tag"`
+	TrailerURL        string   `json:"trailer_url" validate:"omitempty,url"`
+	BackdropURL       string   `json:"backdrop_url" validate:"omitempty,url"`
 }
 
 type UpdateMovieRequest struct {
@@ -14,4 +25,25 @@ type UpdateMovieRequest struct {
 	Duration    int    `json:"duration" validate:"omitempty,min=1"`
 	Genre       string `json:"genre"`
 	PosterURL   string `json:"poster_url" validate:"omitempty,url"`
+
+	// Metadata: kosong / null = tidak diubah
+	AgeRating         string   `json:"age_rating" validate:"omitempty,oneof=SU 13+ 17+ 21+"`
+	ReleaseDate       string   `json:"release_date" validate:"omitempty,datetime=2006-01-02"`
+	EndOfRunDate      string   `json:"end_of_run_date" validate:"omitempty,datetime=2006-01-02"`
+	Director          string   `json:"director" validate:"omitempty,max=255"`
+	Cast              []string `json:"cast" validate:"omitempty,dive,required,max=255"`
+	OriginalLanguage  string   `json:"original_language" validate:"omitempty,bcp47_language_tag"`
+	SubtitleLanguages []string `json:"subtitle_languages" validate:"omitempty,dive,bcp47_language_tag"`
+	TrailerURL        string   `json:"trailer_url" validate:"omitempty,url"`
+	BackdropURL       string   `json:"backdrop_url" validate:"omitempty,url"`
+}
+
+// MovieFilterRequest adalah query params listing film (semua opsional)
+type MovieFilterRequest struct {
+	Search           string // Judul atau genre
+	AgeRating        string // Satu atau beberapa, dipisah koma: SU,13+
+	Language         string // Bahasa asli film
+	SubtitleLanguage string // Film yang punya subtitle bahasa tsb
+	ReleasedFrom     string // YYYY-MM-DD (inklusif)
+	ReleasedTo       string // YYYY-MM-DD (inklusif)
 }
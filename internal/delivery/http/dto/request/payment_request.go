@@ -1,5 +1,34 @@
 package request
 
 type PayTransactionRequest struct {
-	PaymentMethod string `json:"payment_method" validate:"required,oneof=credit_card e_wallet qris"`
+	PaymentMethod string `json:"payment_method" validate:"required"` // Kode dari katalog payment_methods
+}
+
+type ExtendTransactionRequest struct {
+	Minutes int `json:"minutes" validate:"required,min=1,max=1440"` // Tambahan waktu pembayaran
+}
+
+type CreatePaymentMethodRequest struct {
+	Code          string   `json:"code" validate:"required,max=50"`
+	DisplayName   string   `json:"display_name" validate:"required,max=100"`
+	IconURL       string   `json:"icon_url" validate:"omitempty,url"`
+	IsEnabled     *bool    `json:"is_enabled"` // Default: true
+	MinAmount     float64  `json:"min_amount" validate:"min=0"`
+	MaxAmount     float64  `json:"max_amount" validate:"min=0"` // 0 = tanpa batas
+	FeeType       string   `json:"fee_type" validate:"omitempty,oneof=percentage fixed"`
+	FeeValue      float64  `json:"fee_value" validate:"min=0"`
+	ExpiryMinutes int      `json:"expiry_minutes" validate:"min=0"`
+	CinemaIDs     []string `json:"cinema_ids" validate:"omitempty,dive,uuid"` // Kosong = semua cinema
+}
+
+type UpdatePaymentMethodRequest struct {
+	DisplayName   string   `json:"display_name" validate:"omitempty,max=100"`
+	IconURL       string   `json:"icon_url" validate:"omitempty,url"`
+	IsEnabled     *bool    `json:"is_enabled"`
+	MinAmount     *float64 `json:"min_amount" validate:"omitempty,min=0"`
+	MaxAmount     *float64 `json:"max_amount" validate:"omitempty,min=0"`
+	FeeType       string   `json:"fee_type" validate:"omitempty,oneof=percentage fixed"`
+	FeeValue      *float64 `json:"fee_value" validate:"omitempty,min=0"`
+	ExpiryMinutes *int     `json:"expiry_minutes" validate:"omitempty,min=0"`
+	CinemaIDs     []string `json:"cinema_ids" validate:"omitempty,dive,uuid"` // null = tidak diubah, [] = semua cinema
 }
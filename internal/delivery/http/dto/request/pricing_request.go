@@ -0,0 +1,51 @@
+package request
+
+type CreatePricingRuleRequest struct {
+	Name        string `json:"name" validate:"required,max=100"`
+	Description string `json:"description"`
+	Priority    int    `json:"priority"`  // Lebih besar = dievaluasi lebih dulu
+	IsActive    *bool  `json:"is_active"` // Default: true
+
+	// Kondisi (kosong = tidak dibatasi)
+	Weekdays       []string `json:"weekdays" validate:"omitempty,dive,oneof=monday tuesday wednesday thursday friday saturday sunday"`
+	StartTime      string   `json:"start_time" validate:"omitempty,datetime=15:04"`
+	EndTime        string   `json:"end_time" validate:"omitempty,datetime=15:04"`
+	MovieIDs       []string `json:"movie_ids" validate:"omitempty,dive,uuid"`
+	SeatCategories []string `json:"seat_categories" validate:"omitempty,dive,oneof=regular premium couple"`
+	DayTypes       []string `json:"day_types" validate:"omitempty,dive,oneof=holiday special_event regular"`
+	Formats        []string `json:"formats" validate:"omitempty,dive,oneof=2d 3d"`
+	Features       []string `json:"features" validate:"omitempty,dive,oneof=imax 4dx dolby_atmos screenx"`
+	AudioLanguages []string `json:"audio_languages" validate:"omitempty,dive,bcp47_language_tag"`
+	MinOccupancy   float64  `json:"min_occupancy" validate:"min=0,max=100"` // Persen kursi terjual
+	MaxOccupancy   float64  `json:"max_occupancy" validate:"min=0,max=100"`
+	WithinHours    int      `json:"within_hours" validate:"min=0"` // Jam sebelum tayang
+
+	AdjustmentType  string  `json:"adjustment_type" validate:"required,oneof=percentage fixed"`
+	AdjustmentValue float64 `json:"adjustment_value" validate:"required"` // Negatif = potongan
+	StopProcessing  bool    `json:"stop_processing"`
+}
+
+type UpdatePricingRuleRequest struct {
+	Name        string  `json:"name" validate:"omitempty,max=100"`
+	Description *string `json:"description"`
+	Priority    *int    `json:"priority"`
+	IsActive    *bool   `json:"is_active"`
+
+	// Kondisi: null = tidak diubah, [] / "" = hapus batasan
+	Weekdays       []string `json:"weekdays" validate:"omitempty,dive,oneof=monday tuesday wednesday thursday friday saturday sunday"`
+	StartTime      *string  `json:"start_time"`
+	EndTime        *string  `json:"end_time"`
+	MovieIDs       []string `json:"movie_ids" validate:"omitempty,dive,uuid"`
+	SeatCategories []string `json:"seat_categories" validate:"omitempty,dive,oneof=regular premium couple"`
+	DayTypes       []string `json:"day_types" validate:"omitempty,dive,oneof=holiday special_event regular"`
+	Formats        []string `json:"formats" validate:"omitempty,dive,oneof=2d 3d"`
+	Features       []string `json:"features" validate:"omitempty,dive,oneof=imax 4dx dolby_atmos screenx"`
+	AudioLanguages []string `json:"audio_languages" validate:"omitempty,dive,bcp47_language_tag"`
+	MinOccupancy   *float64 `json:"min_occupancy" validate:"omitempty,min=0,max=100"`
+	MaxOccupancy   *float64 `json:"max_occupancy" validate:"omitempty,min=0,max=100"`
+	WithinHours    *int     `json:"within_hours" validate:"omitempty,min=0"`
+
+	AdjustmentType  string   `json:"adjustment_type" validate:"omitempty,oneof=percentage fixed"`
+	AdjustmentValue *float64 `json:"adjustment_value"`
+	StopProcessing  *bool    `json:"stop_processing"`
+}
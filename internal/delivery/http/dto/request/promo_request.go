@@ -6,12 +6,48 @@ type CreatePromoRequest struct {
 	Code          string    `json:"code" binding:"required"`
 	DiscountType  string    `json:"discount_type" binding:"required,oneof=percentage fixed"`
 	DiscountValue float64   `json:"discount_value" binding:"required"`
+	ValidFrom     time.Time `json:"valid_from"` // Optional, default: sekarang
 	ValidUntil    time.Time `json:"valid_until" binding:"required"`
+
+	// Batasan pemakaian (0 = tanpa batas)
+	UsageLimit           int     `json:"usage_limit" binding:"min=0"`
+	PerUserLimit         int     `json:"per_user_limit" binding:"min=0"`
+	MinTransactionAmount float64 `json:"min_transaction_amount" binding:"min=0"`
+	MaxDiscountAmount    float64 `json:"max_discount_amount" binding:"min=0"`
+
+	// Eligibility (kosong = tidak dibatasi)
+	MovieIDs       []string `json:"movie_ids" binding:"omitempty,dive,uuid"`
+	Genres         []string `json:"genres"`
+	StudioIDs      []string `json:"studio_ids" binding:"omitempty,dive,uuid"`
+	Weekdays       []string `json:"weekdays" binding:"omitempty,dive,oneof=monday tuesday wednesday thursday friday saturday sunday"`
+	StartTime      string   `json:"start_time" binding:"omitempty,datetime=15:04"`
+	EndTime        string   `json:"end_time" binding:"omitempty,datetime=15:04"`
+	SeatCategories []string `json:"seat_categories" binding:"omitempty,dive,oneof=regular premium couple"`
+	DayTypes       []string `json:"day_types" binding:"omitempty,dive,oneof=holiday special_event regular"`
+	FirstTimeOnly  bool     `json:"first_time_only"`
 }
 
 type UpdatePromoRequest struct {
 	Code          string    `json:"code"`
 	DiscountType  string    `json:"discount_type" binding:"omitempty,oneof=percentage fixed"`
 	DiscountValue float64   `json:"discount_value"`
+	ValidFrom     time.Time `json:"valid_from"`
 	ValidUntil    time.Time `json:"valid_until"`
+
+	// Pointer agar bisa di-reset ke 0 (tanpa batas)
+	UsageLimit           *int     `json:"usage_limit" binding:"omitempty,min=0"`
+	PerUserLimit         *int     `json:"per_user_limit" binding:"omitempty,min=0"`
+	MinTransactionAmount *float64 `json:"min_transaction_amount" binding:"omitempty,min=0"`
+	MaxDiscountAmount    *float64 `json:"max_discount_amount" binding:"omitempty,min=0"`
+
+	// Eligibility: null = tidak diubah, [] / "" = hapus batasan
+	MovieIDs       []string `json:"movie_ids" binding:"omitempty,dive,uuid"`
+	Genres         []string `json:"genres"`
+	StudioIDs      []string `json:"studio_ids" binding:"omitempty,dive,uuid"`
+	Weekdays       []string `json:"weekdays" binding:"omitempty,dive,oneof=monday tuesday wednesday thursday friday saturday sunday"`
+	StartTime      *string  `json:"start_time" binding:"omitempty"`
+	EndTime        *string  `json:"end_time" binding:"omitempty"`
+	SeatCategories []string `json:"seat_categories" binding:"omitempty,dive,oneof=regular premium couple"`
+	DayTypes       []string `json:"day_types" binding:"omitempty,dive,oneof=holiday special_event regular"`
+	FirstTimeOnly  *bool    `json:"first_time_only"`
 }
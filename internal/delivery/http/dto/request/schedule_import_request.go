@@ -0,0 +1,16 @@
+package request
+
+// ScheduleImportRow adalah 1 baris file import jadwal.
+// CSV memakai header yang sama dengan json tag: movie,studio,start_time,end_time,price
+// (opsional: format,audio_language,subtitle_language)
+type ScheduleImportRow struct {
+	Movie     string  `json:"movie"`      // UUID atau judul film
+	Studio    string  `json:"studio"`     // UUID atau nama studio
+	StartTime string  `json:"start_time"` // RFC3339 atau "2006-01-02 15:04" (jam lokal studio)
+	EndTime   string  `json:"end_time"`   // Opsional, default start + pre-show + durasi film
+	Price     float64 `json:"price"`
+
+	Format           string `json:"format"` // 2d (default) / 3d
+	AudioLanguage    string `json:"audio_language"`
+	SubtitleLanguage string `json:"subtitle_language"`
+}
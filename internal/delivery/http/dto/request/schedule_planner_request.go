@@ -0,0 +1,27 @@
+package request
+
+// PlanScheduleRequest meminta usulan jadwal otomatis untuk beberapa studio dalam 1 minggu
+type PlanScheduleRequest struct {
+	StudioIDs      []string           `json:"studio_ids" validate:"required,min=1,dive,uuid"`
+	WeekStart      string             `json:"week_start" validate:"required,datetime=2006-01-02"`
+	Days           int                `json:"days" validate:"omitempty,min=1,max=14"`               // Default: 7
+	OpeningTime    string             `json:"opening_time" validate:"omitempty,datetime=15:04"`     // Default: 10:00
+	ClosingTime    string             `json:"closing_time" validate:"omitempty,datetime=15:04"`     // Default: 00:00 (tengah malam). Lebih kecil dari opening = hari berikutnya
+	PrimeTimeStart string             `json:"prime_time_start" validate:"omitempty,datetime=15:04"` // Default: 17:00
+	PrimeTimeEnd   string             `json:"prime_time_end" validate:"omitempty,datetime=15:04"`   // Default: 22:00
+	SlotMinutes    int                `json:"slot_minutes" validate:"omitempty,min=5,max=60"`       // Pembulatan jam mulai. Default: 15
+	Price          float64            `json:"price" validate:"required,min=0"`                      // Harga dasar jika film tidak punya harga sendiri
+	Movies         []PlanMovieRequest `json:"movies" validate:"required,min=1,dive"`
+}
+
+type PlanMovieRequest struct {
+	MovieID     string  `json:"movie_id" validate:"required,uuid"`
+	Weight      float64 `json:"weight" validate:"omitempty,min=0"`       // Prioritas prime time. Default: 1
+	TargetShows int     `json:"target_shows" validate:"omitempty,min=0"` // Maksimal tayang per minggu (0 = tanpa batas)
+	Price       float64 `json:"price" validate:"omitempty,min=0"`        // 0 = pakai price umum
+}
+
+// CommitSchedulePlanRequest menyimpan usulan (boleh sudah diedit admin) lewat alur create jadwal biasa
+type CommitSchedulePlanRequest struct {
+	Schedules []CreateScheduleRequest `json:"schedules" validate:"required,min=1,max=500,dive"`
+}
@@ -6,8 +6,49 @@ type CreateScheduleRequest struct {
 	StudioID  string    `json:"studio_id" validate:"required,uuid"`
 	MovieID   string    `json:"movie_id" validate:"required,uuid"`
 	StartTime time.Time `json:"start_time" validate:"required"`
-	EndTime   time.Time `json:"end_time" validate:"required,gtfield=StartTime"`
+	EndTime   time.Time `json:"end_time" validate:"omitempty,gtfield=StartTime"` // Opsional: default start + pre-show + durasi film
 	Price     float64   `json:"price" validate:"required,min=0"`
+	MinPrice  float64   `json:"min_price" validate:"min=0"` // Batas bawah harga dinamis (0 = tanpa batas)
+	MaxPrice  float64   `json:"max_price" validate:"min=0"` // Batas atas harga dinamis (0 = tanpa batas)
+
+	// Jendela penjualan (opsional, default dari config)
+	SalesOpenAt  *time.Time `json:"sales_open_at"`
+	SalesCloseAt *time.Time `json:"sales_close_at"`
+
+	ScreeningRequest
+}
+
+// ScreeningRequest adalah atribut tayang. Format 3D & fitur premium harus didukung studio.
+type ScreeningRequest struct {
+	Format           string   `json:"format" validate:"omitempty,oneof=2d 3d"` // Default: 2d
+	Features         []string `json:"features" validate:"omitempty,dive,oneof=imax 4dx dolby_atmos screenx"`
+	AudioLanguage    string   `json:"audio_language" validate:"omitempty,bcp47_language_tag"`    // Mis. en, id
+	SubtitleLanguage string   `json:"subtitle_language" validate:"omitempty,bcp47_language_tag"` // Kosong = tanpa subtitle
+}
+
+// CancelScheduleRequest membatalkan jadwal beserta semua transaksinya
+type CancelScheduleRequest struct {
+	Reason                string `json:"reason" validate:"required,max=500"`
+	Resolution            string `json:"resolution" validate:"omitempty,oneof=refund credit"` // Untuk transaksi paid. Default: refund
+	ReplacementScheduleID string `json:"replacement_schedule_id" validate:"omitempty,uuid"`   // Jadwal pengganti yang ditawarkan untuk pesan ulang
+}
+
+// ScheduleFilterRequest adalah query params listing jadwal publik (semua opsional)
+type ScheduleFilterRequest struct {
+	Date        string // YYYY-MM-DD, satu hari penuh
+	DateFrom    string // YYYY-MM-DD (inklusif)
+	DateTo      string // YYYY-MM-DD (inklusif)
+	MovieID     string
+	StudioID    string
+	CinemaID    string
+	MinPrice    string
+	MaxPrice    string
+	IncludePast bool // Default false: jadwal yang sudah mulai disembunyikan
+
+	Format           string // 2d / 3d
+	Feature          string // Fitur tayang, mis. imax
+	AudioLanguage    string
+	SubtitleLanguage string
 }
 
 type UpdateScheduleRequest struct {
@@ -16,4 +57,27 @@ type UpdateScheduleRequest struct {
 	StartTime time.Time `json:"start_time"`
 	EndTime   time.Time `json:"end_time" validate:"omitempty,gtfield=StartTime"`
 	Price     float64   `json:"price" validate:"omitempty,min=0"`
+	MinPrice  *float64  `json:"min_price" validate:"omitempty,min=0"`
+	MaxPrice  *float64  `json:"max_price" validate:"omitempty,min=0"`
+
+	// Jendela penjualan. Kirim reset_sales_window=true untuk kembali ke default config
+	SalesOpenAt      *time.Time `json:"sales_open_at"`
+	SalesCloseAt     *time.Time `json:"sales_close_at"`
+	ResetSalesWindow bool       `json:"reset_sales_window"`
+
+	// Atribut tayang: null = tidak diubah, "" / [] = hapus (format kembali 2d)
+	Format           *string  `json:"format" validate:"omitempty,oneof=2d 3d"`
+	Features         []string `json:"features" validate:"omitempty,dive,oneof=imax 4dx dolby_atmos screenx"`
+	AudioLanguage    *string  `json:"audio_language" validate:"omitempty,bcp47_language_tag"`
+	SubtitleLanguage *string  `json:"subtitle_language" validate:"omitempty,bcp47_language_tag"`
+
+	// Wajib jika studio diganti & sudah ada tiket terjual
+	SeatMapping  []SeatMappingRequest `json:"seat_mapping" validate:"omitempty,dive"`
+	AutoMapSeats bool                 `json:"auto_map_seats"` // Petakan otomatis ke kursi dengan baris & nomor yang sama
+}
+
+// SeatMappingRequest memindahkan tiket dari kursi studio lama ke kursi studio baru
+type SeatMappingRequest struct {
+	FromSeatID string `json:"from_seat_id" validate:"required,uuid"`
+	ToSeatID   string `json:"to_seat_id" validate:"required,uuid"`
 }
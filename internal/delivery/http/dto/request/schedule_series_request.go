@@ -0,0 +1,26 @@
+package request
+
+type CreateScheduleSeriesRequest struct {
+	StudioID   string   `json:"studio_id" validate:"required,uuid"`
+	MovieID    string   `json:"movie_id" validate:"required,uuid"`
+	StartDate  string   `json:"start_date" validate:"required,datetime=2006-01-02"`
+	EndDate    string   `json:"end_date" validate:"required,datetime=2006-01-02"`
+	Weekdays   []string `json:"weekdays" validate:"omitempty,dive,oneof=monday tuesday wednesday thursday friday saturday sunday"` // Kosong = setiap hari
+	StartTimes []string `json:"start_times" validate:"required,min=1,dive,datetime=15:04"`                                         // Jam tayang harian
+	Price      float64  `json:"price" validate:"required,min=0"`
+	MinPrice   float64  `json:"min_price" validate:"min=0"`
+	MaxPrice   float64  `json:"max_price" validate:"min=0"`
+
+	ScreeningRequest // Berlaku untuk semua occurrence
+
+	// false (default): jika ada 1 occurrence bentrok, tidak ada yang dibuat
+	// true: occurrence yang bentrok dilewati, sisanya tetap dibuat
+	SkipConflicts bool `json:"skip_conflicts"`
+}
+
+// UpdateScheduleSeriesRequest: scope (all / future) dikirim lewat query string
+type UpdateScheduleSeriesRequest struct {
+	Price    *float64 `json:"price" validate:"omitempty,min=0"`
+	MinPrice *float64 `json:"min_price" validate:"omitempty,min=0"`
+	MaxPrice *float64 `json:"max_price" validate:"omitempty,min=0"`
+}
@@ -1,11 +1,27 @@
 package request
 
 type CreateStudioRequest struct {
-	Name     string `json:"name" validate:"required"`
-	Capacity int    `json:"capacity" validate:"required,min=1"`
+	CinemaID        string `json:"cinema_id" validate:"omitempty,uuid"` // Timezone default mengikuti cinema
+	Name            string `json:"name" validate:"required"`
+	Capacity        int    `json:"capacity" validate:"required,min=1"`
+	CleaningMinutes *int   `json:"cleaning_minutes" validate:"omitempty,min=0,max=180"` // Default: 15
+	Timezone        string `json:"timezone" validate:"omitempty,timezone"`              // IANA, default: DEFAULT_TIMEZONE
+
+	Features []string `json:"features" validate:"omitempty,dive,oneof=3d imax 4dx dolby_atmos screenx"`
 }
 
 type UpdateStudioRequest struct {
-	Name     string `json:"name"`
-	Capacity int    `json:"capacity" validate:"omitempty,min=1"`
+	CinemaID        string `json:"cinema_id" validate:"omitempty,uuid"`
+	Name            string `json:"name"`
+	Capacity        int    `json:"capacity" validate:"omitempty,min=1"`
+	CleaningMinutes *int   `json:"cleaning_minutes" validate:"omitempty,min=0,max=180"`
+	Timezone        string `json:"timezone" validate:"omitempty,timezone"`
+
+	// null = tidak diubah, [] = hapus semua. Tidak bisa menghapus fitur yang masih dipakai jadwal mendatang.
+	Features []string `json:"features" validate:"omitempty,dive,oneof=3d imax 4dx dolby_atmos screenx"`
+}
+
+type UpdateSeatCategoryRequest struct {
+	SeatIDs  []string `json:"seat_ids" validate:"required,min=1,dive,uuid"`
+	Category string   `json:"category" validate:"required,oneof=regular premium couple"`
 }
@@ -0,0 +1,22 @@
+package request
+
+type CreateTicketTypeRequest struct {
+	Code            string  `json:"code" validate:"required,max=30"`
+	Name            string  `json:"name" validate:"required,max=100"`
+	Description     string  `json:"description"`
+	AdjustmentType  string  `json:"adjustment_type" validate:"required,oneof=percentage fixed"`
+	AdjustmentValue float64 `json:"adjustment_value"`                         // Negatif = potongan, 0 = harga normal
+	Requirement     string  `json:"requirement" validate:"omitempty,max=255"` // Mis. "Show a valid student ID at check-in"
+	IsActive        *bool   `json:"is_active"`                                // Default: true
+	SortOrder       int     `json:"sort_order"`
+}
+
+type UpdateTicketTypeRequest struct {
+	Name            string   `json:"name" validate:"omitempty,max=100"`
+	Description     *string  `json:"description"`
+	AdjustmentType  string   `json:"adjustment_type" validate:"omitempty,oneof=percentage fixed"`
+	AdjustmentValue *float64 `json:"adjustment_value"`
+	Requirement     *string  `json:"requirement" validate:"omitempty,max=255"`
+	IsActive        *bool    `json:"is_active"`
+	SortOrder       *int     `json:"sort_order"`
+}
@@ -11,4 +11,8 @@ type UserResponse struct {
 	Name  string    `json:"name"`
 	Email string    `json:"email"`
 	Role  string    `json:"role"`
+
+	CreditBalance float64 `json:"credit_balance"`
+
+	CinemaIDs []uuid.UUID `json:"cinema_ids,omitempty"` // Cinema yang dikelola admin cabang
 }
@@ -0,0 +1,14 @@
+package response
+
+// CalendarImportResponse adalah ringkasan hasil import file iCalendar
+type CalendarImportResponse struct {
+	Created int      `json:"created"`
+	Updated int      `json:"updated"`
+	Errors  []string `json:"errors,omitempty"`
+}
+
+// CalendarFeedResponse adalah URL feed .ics tiket user (rahasia, jangan dibagikan)
+type CalendarFeedResponse struct {
+	URL       string `json:"url"`
+	WebcalURL string `json:"webcal_url"` // Untuk tombol "subscribe" di aplikasi kalender
+}
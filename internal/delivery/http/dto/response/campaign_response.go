@@ -0,0 +1,24 @@
+package response
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type VoucherCodeResponse struct {
+	Code          string     `json:"code"`
+	Status        string     `json:"status"` // unused, redeemed, revoked
+	RedeemedBy    *uuid.UUID `json:"redeemed_by,omitempty"`
+	RedeemedEmail string     `json:"redeemed_email,omitempty"`
+	RedeemedAt    *time.Time `json:"redeemed_at,omitempty"`
+	TransactionID *uuid.UUID `json:"transaction_id,omitempty"`
+	RevokedAt     *time.Time `json:"revoked_at,omitempty"`
+}
+
+type CampaignSummaryResponse struct {
+	Total    int64 `json:"total"`
+	Unused   int64 `json:"unused"`
+	Redeemed int64 `json:"redeemed"`
+	Revoked  int64 `json:"revoked"`
+}
@@ -0,0 +1,22 @@
+package response
+
+import "github.com/google/uuid"
+
+type CinemaResponse struct {
+	ID          uuid.UUID `json:"id"`
+	Name        string    `json:"name"`
+	Address     string    `json:"address"`
+	City        string    `json:"city"`
+	Latitude    float64   `json:"latitude"`
+	Longitude   float64   `json:"longitude"`
+	Timezone    string    `json:"timezone"`
+	OpeningTime string    `json:"opening_time"`
+	ClosingTime string    `json:"closing_time"`
+	Phone       string    `json:"phone"`
+	Email       string    `json:"email"`
+	IsActive    bool      `json:"is_active"`
+	// Hanya di pencarian nearby
+	DistanceKm *float64 `json:"distance_km,omitempty"`
+	// Hanya di detail cinema
+	Studios []StudioResponse `json:"studios,omitempty"`
+}
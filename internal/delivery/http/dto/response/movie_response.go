@@ -9,4 +9,14 @@ type MovieResponse struct {
 	Duration    int       `json:"duration"`
 	Genre       string    `json:"genre"`
 	PosterURL   string    `json:"poster_url"`
+
+	AgeRating         string   `json:"age_rating,omitempty"`
+	ReleaseDate       string   `json:"release_date,omitempty"`    // YYYY-MM-DD
+	EndOfRunDate      string   `json:"end_of_run_date,omitempty"` // YYYY-MM-DD
+	Director          string   `json:"director,omitempty"`
+	Cast              []string `json:"cast,omitempty"`
+	OriginalLanguage  string   `json:"original_language,omitempty"`
+	SubtitleLanguages []string `json:"subtitle_languages,omitempty"`
+	TrailerURL        string   `json:"trailer_url,omitempty"`
+	BackdropURL       string   `json:"backdrop_url,omitempty"`
 }
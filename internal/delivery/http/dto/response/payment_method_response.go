@@ -0,0 +1,13 @@
+package response
+
+import "github.com/google/uuid"
+
+// PaymentMethodResponse adalah metode pembayaran yang valid untuk transaksi tertentu
+type PaymentMethodResponse struct {
+	ID          uuid.UUID `json:"id"`
+	Code        string    `json:"code"`
+	DisplayName string    `json:"display_name"`
+	IconURL     string    `json:"icon_url"`
+	Fee         float64   `json:"fee"`          // Biaya untuk transaksi ini
+	TotalAmount float64   `json:"total_amount"` // Harga akhir + biaya
+}
@@ -0,0 +1,30 @@
+package response
+
+import "github.com/google/uuid"
+
+// AppliedPricingRuleResponse adalah rule yang cocok & mengubah harga kursi
+type AppliedPricingRuleResponse struct {
+	RuleID          uuid.UUID `json:"rule_id"`
+	Name            string    `json:"name"`
+	AdjustmentType  string    `json:"adjustment_type"`
+	AdjustmentValue float64   `json:"adjustment_value"`
+	Amount          float64   `json:"amount"` // Perubahan harga dalam rupiah
+}
+
+type PricingPreviewCategoryResponse struct {
+	SeatCategory string                       `json:"seat_category"`
+	Price        float64                      `json:"price"`
+	AppliedRules []AppliedPricingRuleResponse `json:"applied_rules"`
+}
+
+// PricingPreviewResponse menampilkan harga akhir per kategori kursi untuk satu jadwal
+type PricingPreviewResponse struct {
+	ScheduleID uuid.UUID                        `json:"schedule_id"`
+	BasePrice  float64                          `json:"base_price"`
+	Legacy     bool                             `json:"legacy_pricing"` // true = pricing rules tidak diterapkan
+	MinPrice   float64                          `json:"min_price"`
+	MaxPrice   float64                          `json:"max_price"`
+	Occupancy  float64                          `json:"occupancy"` // Persen kursi terjual
+	DayTypes   []string                         `json:"day_types"` // holiday, special_event, regular
+	Categories []PricingPreviewCategoryResponse `json:"categories"`
+}
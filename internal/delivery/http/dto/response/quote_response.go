@@ -0,0 +1,44 @@
+package response
+
+import "github.com/google/uuid"
+
+type QuoteSeatResponse struct {
+	SeatID     uuid.UUID `json:"seat_id"`
+	RowCode    string    `json:"row_code"`
+	SeatNumber int       `json:"seat_number"`
+	Category   string    `json:"category"`
+	BasePrice  float64   `json:"base_price"` // Harga jadwal
+	Price      float64   `json:"price"`      // Harga kursi ini setelah penyesuaian
+
+	AppliedRules []AppliedPricingRuleResponse `json:"applied_rules"`
+
+	TicketType       string  `json:"ticket_type"`
+	TicketTypeName   string  `json:"ticket_type_name,omitempty"`
+	TicketTypeAmount float64 `json:"ticket_type_amount"`    // Penyesuaian harga dari tipe tiket
+	Requirement      string  `json:"requirement,omitempty"` // Syarat yang dicek saat check-in
+}
+
+type QuoteDiscountResponse struct {
+	Code    string  `json:"code"`
+	Applied bool    `json:"applied"`
+	Reason  string  `json:"reason,omitempty"` // Alasan jika promo ditolak
+	Amount  float64 `json:"amount"`
+}
+
+type QuoteFeeResponse struct {
+	Name   string  `json:"name"`
+	Amount float64 `json:"amount"`
+}
+
+// QuoteResponse adalah rincian harga sebelum booking
+type QuoteResponse struct {
+	ScheduleID         uuid.UUID               `json:"schedule_id"`
+	Seats              []QuoteSeatResponse     `json:"seats"`
+	Subtotal           float64                 `json:"subtotal"`
+	Discounts          []QuoteDiscountResponse `json:"discounts"`
+	DiscountAmount     float64                 `json:"discount_amount"`
+	Fees               []QuoteFeeResponse      `json:"fees"`
+	FeeAmount          float64                 `json:"fee_amount"`
+	FinalAmount        float64                 `json:"final_amount"`
+	PaymentMethodError string                  `json:"payment_method_error,omitempty"`
+}
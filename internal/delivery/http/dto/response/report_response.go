@@ -12,3 +12,17 @@ type TopMovieResponse struct {
 	TotalSold  int64   `json:"total_tickets_sold"`
 	TotalSales float64 `json:"total_sales_revenue"`
 }
+
+// DayTypeRevenueResponse membandingkan penjualan hari libur / event / biasa (berdasarkan tanggal tayang)
+type DayTypeRevenueResponse struct {
+	DayType    string  `json:"day_type"`
+	TotalSold  int64   `json:"total_tickets_sold"`
+	TotalSales float64 `json:"total_sales_revenue"`
+}
+
+type TicketTypeSalesResponse struct {
+	TicketType string  `json:"ticket_type"`
+	Name       string  `json:"name"`
+	TotalSold  int64   `json:"total_tickets_sold"`
+	TotalSales float64 `json:"total_sales_revenue"`
+}
@@ -0,0 +1,29 @@
+package response
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ScheduleImportRowResponse adalah hasil validasi / import per baris file
+type ScheduleImportRowResponse struct {
+	Row        int        `json:"row"` // Nomor baris di file (CSV: termasuk header) atau index JSON mulai dari 1
+	MovieID    *uuid.UUID `json:"movie_id,omitempty"`
+	StudioID   *uuid.UUID `json:"studio_id,omitempty"`
+	StartTime  *time.Time `json:"start_time,omitempty"`
+	EndTime    *time.Time `json:"end_time,omitempty"`
+	Price      float64    `json:"price"`
+	Status     string     `json:"status"` // valid, invalid, created, skipped
+	Errors     []string   `json:"errors,omitempty"`
+	ScheduleID *uuid.UUID `json:"schedule_id,omitempty"`
+}
+
+type ScheduleImportResponse struct {
+	DryRun  bool                        `json:"dry_run"`
+	Total   int                         `json:"total"`
+	Valid   int                         `json:"valid"`
+	Invalid int                         `json:"invalid"`
+	Created int                         `json:"created"`
+	Rows    []ScheduleImportRowResponse `json:"rows"`
+}
@@ -0,0 +1,55 @@
+package response
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ScheduleProposalResponse memakai json field yang sama dengan CreateScheduleRequest
+// agar bisa langsung dikirim ulang ke endpoint commit
+type ScheduleProposalResponse struct {
+	StudioID   uuid.UUID `json:"studio_id"`
+	StudioName string    `json:"studio_name"`
+	MovieID    uuid.UUID `json:"movie_id"`
+	MovieTitle string    `json:"movie_title"`
+	StartTime  time.Time `json:"start_time"`
+	EndTime    time.Time `json:"end_time"`
+	Price      float64   `json:"price"`
+	PrimeTime  bool      `json:"prime_time"`
+}
+
+type PlannerMovieSummaryResponse struct {
+	MovieID        uuid.UUID `json:"movie_id"`
+	Title          string    `json:"title"`
+	Weight         float64   `json:"weight"`
+	TargetShows    int       `json:"target_shows"`
+	PlannedShows   int       `json:"planned_shows"`
+	PrimeTimeShows int       `json:"prime_time_shows"`
+}
+
+type SchedulePlanResponse struct {
+	WeekStart          string                        `json:"week_start"`
+	Days               int                           `json:"days"`
+	TotalProposals     int                           `json:"total_proposals"`
+	PrimeTimeProposals int                           `json:"prime_time_proposals"`
+	Movies             []PlannerMovieSummaryResponse `json:"movies"`
+	Proposals          []ScheduleProposalResponse    `json:"proposals"`
+}
+
+// PlanCommitResultResponse adalah hasil create per usulan
+type PlanCommitResultResponse struct {
+	Index      int        `json:"index"`
+	StudioID   string     `json:"studio_id"`
+	MovieID    string     `json:"movie_id"`
+	StartTime  time.Time  `json:"start_time"`
+	ScheduleID *uuid.UUID `json:"schedule_id,omitempty"`
+	Error      string     `json:"error,omitempty"`
+}
+
+type SchedulePlanCommitResponse struct {
+	Total   int                        `json:"total"`
+	Created int                        `json:"created"`
+	Failed  int                        `json:"failed"`
+	Results []PlanCommitResultResponse `json:"results"`
+}
@@ -8,10 +8,72 @@ import (
 
 // Nested struct untuk response yang rapi
 type ScheduleResponse struct {
-	ID        uuid.UUID      `json:"id"`
-	StartTime time.Time      `json:"start_time"`
-	EndTime   time.Time      `json:"end_time"`
-	Price     float64        `json:"price"`
-	Studio    StudioResponse `json:"studio"`
-	Movie     MovieResponse  `json:"movie"`
+	ID uuid.UUID `json:"id"`
+	// Waktu dalam zona waktu lokal studio (offset ikut di RFC3339)
+	StartTime time.Time `json:"start_time"`
+	EndTime   time.Time `json:"end_time"`
+	Timezone  string    `json:"timezone"`
+	Price     float64   `json:"price"`
+	MinPrice  float64   `json:"min_price"`
+	MaxPrice  float64   `json:"max_price"`
+	// Atribut tayang
+	Format           string   `json:"format"`
+	Features         []string `json:"features"`
+	AudioLanguage    string   `json:"audio_language,omitempty"`
+	SubtitleLanguage string   `json:"subtitle_language,omitempty"`
+	// Jendela penjualan yang diatur khusus (kosong = default config)
+	SalesOpenAt  *time.Time `json:"sales_open_at,omitempty"`
+	SalesCloseAt *time.Time `json:"sales_close_at,omitempty"`
+	// Ketersediaan kursi agar app bisa render grid jadwal tanpa request tambahan
+	AvailableSeats int  `json:"available_seats"`
+	SoldOut        bool `json:"sold_out"`
+	// Status pembatalan
+	Status                string         `json:"status"`
+	CancellationReason    string         `json:"cancellation_reason,omitempty"`
+	ReplacementScheduleID *uuid.UUID     `json:"replacement_schedule_id,omitempty"`
+	Studio                StudioResponse `json:"studio"`
+	Movie                 MovieResponse  `json:"movie"`
+}
+
+// ScheduleCancellationResponse adalah ringkasan penanganan transaksi saat jadwal dibatalkan
+type ScheduleCancellationResponse struct {
+	ScheduleID            uuid.UUID   `json:"schedule_id"`
+	Reason                string      `json:"reason"`
+	Resolution            string      `json:"resolution"` // refund / credit untuk transaksi paid
+	ReplacementScheduleID *uuid.UUID  `json:"replacement_schedule_id,omitempty"`
+	AffectedTransactions  int         `json:"affected_transactions"`
+	Released              int         `json:"released"` // Transaksi pending yang dibatalkan
+	Refunded              int         `json:"refunded"`
+	Credited              int         `json:"credited"`
+	RefundedAmount        float64     `json:"refunded_amount"`
+	CreditedAmount        float64     `json:"credited_amount"`
+	FailedTransactionIDs  []uuid.UUID `json:"failed_transaction_ids"` // Perlu ditangani manual
+}
+
+// ScheduleUpdateImpactResponse menunjukkan dampak perubahan jadwal ke tiket yang sudah terjual
+type ScheduleUpdateImpactResponse struct {
+	ScheduleID           uuid.UUID          `json:"schedule_id"`
+	DryRun               bool               `json:"dry_run"`
+	CanApply             bool               `json:"can_apply"`
+	Issues               []string           `json:"issues"` // Alasan update akan ditolak
+	SoldTickets          int                `json:"sold_tickets"`
+	AffectedTransactions int                `json:"affected_transactions"`
+	StudioChanged        bool               `json:"studio_changed"`
+	TimeChanged          bool               `json:"time_changed"`
+	NotifyHolders        bool               `json:"notify_holders"` // Pemegang tiket akan diberi email
+	OldStudioID          uuid.UUID          `json:"old_studio_id"`
+	NewStudioID          uuid.UUID          `json:"new_studio_id"`
+	OldStartTime         time.Time          `json:"old_start_time"`
+	NewStartTime         time.Time          `json:"new_start_time"`
+	OldEndTime           time.Time          `json:"old_end_time"`
+	NewEndTime           time.Time          `json:"new_end_time"`
+	SeatMoves            []SeatMoveResponse `json:"seat_moves"`
+}
+
+type SeatMoveResponse struct {
+	TicketID   uuid.UUID  `json:"ticket_id"`
+	FromSeatID uuid.UUID  `json:"from_seat_id"`
+	FromSeat   string     `json:"from_seat"`
+	ToSeatID   *uuid.UUID `json:"to_seat_id"` // Nil jika belum ada kursi tujuan
+	ToSeat     string     `json:"to_seat,omitempty"`
 }
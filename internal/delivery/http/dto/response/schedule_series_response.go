@@ -0,0 +1,24 @@
+package response
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ScheduleOccurrenceResponse adalah hasil per occurrence saat series dibuat / diubah / dihapus
+type ScheduleOccurrenceResponse struct {
+	ScheduleID *uuid.UUID `json:"schedule_id,omitempty"`
+	StartTime  time.Time  `json:"start_time"`
+	EndTime    time.Time  `json:"end_time"`
+	Status     string     `json:"status"` // created, conflict, updated, deleted, skipped
+	Reason     string     `json:"reason,omitempty"`
+}
+
+type ScheduleSeriesResponse struct {
+	SeriesID    uuid.UUID                    `json:"series_id"`
+	Total       int                          `json:"total"`
+	Succeeded   int                          `json:"succeeded"`
+	Failed      int                          `json:"failed"` // Bentrok / dilewati
+	Occurrences []ScheduleOccurrenceResponse `json:"occurrences"`
+}
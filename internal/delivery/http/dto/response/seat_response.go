@@ -1,10 +1,26 @@
 package response
 
-import "github.com/google/uuid"
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
 
 type SeatAvailabilityResponse struct {
 	ID         uuid.UUID `json:"id"`
 	RowCode    string    `json:"row_code"`
 	SeatNumber int       `json:"seat_number"`
+	Category   string    `json:"category"`
 	IsBooked   bool      `json:"is_booked"` // True jika sudah ada yang punya
 }
+
+// ScheduleSeatsResponse adalah denah kursi jadwal beserta status penjualannya
+type ScheduleSeatsResponse struct {
+	ScheduleID uuid.UUID `json:"schedule_id"`
+	Sellable   bool      `json:"sellable"`
+	// Alasan tidak bisa dijual: not_on_sale_yet, sales_closed, cancelled, sold_out
+	Reason       string                     `json:"reason,omitempty"`
+	SalesOpenAt  *time.Time                 `json:"sales_open_at,omitempty"` // Kosong = sudah dijual sejak jadwal dibuat
+	SalesCloseAt time.Time                  `json:"sales_close_at"`
+	Seats        []SeatAvailabilityResponse `json:"seats"`
+}
@@ -3,7 +3,13 @@ package response
 import "github.com/google/uuid"
 
 type StudioResponse struct {
-	ID       uuid.UUID `json:"id"`
-	Name     string    `json:"name"`
-	Capacity int       `json:"capacity"`
+	ID       uuid.UUID  `json:"id"`
+	CinemaID *uuid.UUID `json:"cinema_id"`
+	Name     string     `json:"name"`
+	Capacity int        `json:"capacity"`
+
+	CleaningMinutes int    `json:"cleaning_minutes"`
+	Timezone        string `json:"timezone"`
+
+	Features []string `json:"features"` // Kapabilitas: 3d, imax, 4dx, dolby_atmos, screenx
 }
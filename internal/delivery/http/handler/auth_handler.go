@@ -3,6 +3,7 @@ package handler
 import (
 	"movie-app/internal/delivery/http/dto/request"
 	"movie-app/internal/delivery/http/dto/response"
+	"movie-app/internal/domain"
 	"movie-app/internal/usecase"
 	"movie-app/pkg/utils"
 	"movie-app/pkg/validator"
@@ -58,11 +59,24 @@ func (h *AuthHandler) Register(c *gin.Context) {
 		Name:  user.Name,
 		Email: user.Email,
 		Role:  string(user.Role),
+
+		CreditBalance: user.CreditBalance,
 	}
 
 	utils.SuccessResponse(c, http.StatusCreated, "User registered successfully", userResponse)
 }
 
+// RegisterAdmin godoc
+// @Summary      Create branch admin
+// @Description  Create a branch admin account without cinemas; assign them with PUT /admins/{id}/cinemas. The first super admin is created with the superadmin CLI (Super admin only)
+// @Tags         Auth
+// @Accept       json
+// @Produce      json
+// @Param        request body request.RegisterRequest true "Admin Data"
+// @Success      201  {object}  utils.APIResponse{data=response.UserResponse}
+// @Failure      409  {object}  utils.APIResponse
+// @Router       /admins [post]
+// @Security     BearerAuth
 func (h *AuthHandler) RegisterAdmin(c *gin.Context) {
 	var req request.RegisterRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
@@ -86,6 +100,8 @@ func (h *AuthHandler) RegisterAdmin(c *gin.Context) {
 		Name:  user.Name,
 		Email: user.Email,
 		Role:  string(user.Role),
+
+		CreditBalance: user.CreditBalance,
 	}
 
 	utils.SuccessResponse(c, http.StatusCreated, "Admin registered successfully", userResponse)
@@ -126,6 +142,9 @@ func (h *AuthHandler) GetProfile(c *gin.Context) {
 		Name:  user.Name,
 		Email: user.Email,
 		Role:  string(user.Role),
+
+		CreditBalance: user.CreditBalance,
+		CinemaIDs:     userCinemaIDs(user),
 	}
 
 	utils.SuccessResponse(c, http.StatusOK, "User profile retrieved", userResponse)
@@ -162,3 +181,60 @@ func (h *AuthHandler) Login(c *gin.Context) {
 
 	utils.SuccessResponse(c, http.StatusOK, "Login successful", authRes)
 }
+
+// SetAdminCinemas godoc
+// @Summary      Assign cinemas to admin
+// @Description  Replace the cinemas a branch admin manages. Studio, schedule, box office and report endpoints are limited to these cinemas; an empty list removes all access. Takes effect immediately (Super admin only)
+// @Tags         Auth
+// @Accept       json
+// @Produce      json
+// @Param        id       path      string                          true  "Admin User UUID"
+// @Param        request  body      request.SetAdminCinemasRequest  true  "Cinema IDs"
+// @Success      200      {object}  utils.APIResponse{data=response.UserResponse}
+// @Failure      400      {object}  utils.APIResponse
+// @Router       /admins/{id}/cinemas [put]
+// @Security     BearerAuth
+func (h *AuthHandler) SetAdminCinemas(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, "Invalid UUID", nil)
+		return
+	}
+
+	var req request.SetAdminCinemasRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	if err := h.val.Validate(req); err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, "Validation error", validator.FormatError(err))
+		return
+	}
+
+	user, err := h.authUseCase.SetAdminCinemas(id, req)
+	if err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	userResponse := response.UserResponse{
+		ID:    user.ID,
+		Name:  user.Name,
+		Email: user.Email,
+		Role:  string(user.Role),
+
+		CreditBalance: user.CreditBalance,
+		CinemaIDs:     userCinemaIDs(user),
+	}
+
+	utils.SuccessResponse(c, http.StatusOK, "Admin cinemas updated", userResponse)
+}
+
+func userCinemaIDs(user *domain.User) []uuid.UUID {
+	ids := make([]uuid.UUID, 0, len(user.Cinemas))
+	for _, cinema := range user.Cinemas {
+		ids = append(ids, cinema.ID)
+	}
+	return ids
+}
@@ -0,0 +1,180 @@
+package handler
+
+import (
+	"movie-app/internal/delivery/http/dto/response"
+	"movie-app/internal/usecase"
+	"movie-app/pkg/utils"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type CalendarFeedHandler struct {
+	feedUC usecase.CalendarFeedUseCase
+}
+
+func NewCalendarFeedHandler(feedUC usecase.CalendarFeedUseCase) *CalendarFeedHandler {
+	return &CalendarFeedHandler{feedUC}
+}
+
+// MovieFeed godoc
+// @Summary      Movie showtimes feed
+// @Description  iCalendar (.ics) feed of upcoming showtimes for a movie, for calendar subscriptions
+// @Tags         Calendar Feeds
+// @Produce      text/calendar
+// @Param        id   path      string  true  "Movie UUID (optional .ics suffix)"
+// @Success      200  {file}    file
+// @Failure      404  {object}  utils.APIResponse
+// @Router       /feeds/movies/{id} [get]
+func (h *CalendarFeedHandler) MovieFeed(c *gin.Context) {
+	id, err := uuid.Parse(strings.TrimSuffix(c.Param("id"), ".ics"))
+	if err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, "Invalid UUID", nil)
+		return
+	}
+
+	feed, err := h.feedUC.MovieFeed(id)
+	if err != nil {
+		utils.ErrorResponse(c, http.StatusNotFound, err.Error(), nil)
+		return
+	}
+	writeICS(c, "movie-showtimes.ics", feed)
+}
+
+// StudioFeed godoc
+// @Summary      Studio showtimes feed
+// @Description  iCalendar (.ics) feed of upcoming showtimes in a studio, for calendar subscriptions
+// @Tags         Calendar Feeds
+// @Produce      text/calendar
+// @Param        id   path      string  true  "Studio UUID (optional .ics suffix)"
+// @Success      200  {file}    file
+// @Failure      404  {object}  utils.APIResponse
+// @Router       /feeds/studios/{id} [get]
+func (h *CalendarFeedHandler) StudioFeed(c *gin.Context) {
+	id, err := uuid.Parse(strings.TrimSuffix(c.Param("id"), ".ics"))
+	if err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, "Invalid UUID", nil)
+		return
+	}
+
+	feed, err := h.feedUC.StudioFeed(id)
+	if err != nil {
+		utils.ErrorResponse(c, http.StatusNotFound, err.Error(), nil)
+		return
+	}
+	writeICS(c, "studio-showtimes.ics", feed)
+}
+
+// CinemaFeed godoc
+// @Summary      Cinema showtimes feed
+// @Description  iCalendar (.ics) feed of upcoming showtimes in all studios of a cinema, for calendar subscriptions
+// @Tags         Calendar Feeds
+// @Produce      text/calendar
+// @Param        id   path      string  true  "Cinema UUID (optional .ics suffix)"
+// @Success      200  {file}    file
+// @Failure      404  {object}  utils.APIResponse
+// @Router       /feeds/cinemas/{id} [get]
+func (h *CalendarFeedHandler) CinemaFeed(c *gin.Context) {
+	id, err := uuid.Parse(strings.TrimSuffix(c.Param("id"), ".ics"))
+	if err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, "Invalid UUID", nil)
+		return
+	}
+
+	feed, err := h.feedUC.CinemaFeed(id)
+	if err != nil {
+		utils.ErrorResponse(c, http.StatusNotFound, err.Error(), nil)
+		return
+	}
+	writeICS(c, "cinema-showtimes.ics", feed)
+}
+
+// TicketFeed godoc
+// @Summary      My tickets feed
+// @Description  Private iCalendar (.ics) feed of the token owner's paid tickets. The token replaces login so calendar apps can subscribe.
+// @Tags         Calendar Feeds
+// @Produce      text/calendar
+// @Param        token  path      string  true  "Calendar token (optional .ics suffix)"
+// @Success      200    {file}    file
+// @Failure      404    {object}  utils.APIResponse
+// @Router       /feeds/tickets/{token} [get]
+func (h *CalendarFeedHandler) TicketFeed(c *gin.Context) {
+	feed, err := h.feedUC.TicketFeed(strings.TrimSuffix(c.Param("token"), ".ics"))
+	if err != nil {
+		utils.ErrorResponse(c, http.StatusNotFound, err.Error(), nil)
+		return
+	}
+	c.Header("Cache-Control", "private, no-store")
+	writeICS(c, "my-tickets.ics", feed)
+}
+
+// GetTicketFeedURL godoc
+// @Summary      Get my tickets feed URL
+// @Description  Returns the private .ics subscription URL for the logged-in user's tickets (token is created on first call)
+// @Tags         Calendar Feeds
+// @Produce      json
+// @Success      200  {object}  utils.APIResponse{data=response.CalendarFeedResponse}
+// @Router       /tickets/me/calendar [get]
+// @Security     BearerAuth
+func (h *CalendarFeedHandler) GetTicketFeedURL(c *gin.Context) {
+	userIDStr, exists := c.Get("user_id")
+	if !exists {
+		utils.ErrorResponse(c, http.StatusUnauthorized, "Unauthorized", nil)
+		return
+	}
+	userID, _ := uuid.Parse(userIDStr.(string))
+
+	token, err := h.feedUC.GetTicketFeedToken(userID)
+	if err != nil {
+		utils.ErrorResponse(c, http.StatusInternalServerError, err.Error(), nil)
+		return
+	}
+	utils.SuccessResponse(c, http.StatusOK, "Calendar feed", ticketFeedURL(c, token))
+}
+
+// RotateTicketFeedURL godoc
+// @Summary      Rotate my tickets feed URL
+// @Description  Generates a new private token; the previous feed URL stops working
+// @Tags         Calendar Feeds
+// @Produce      json
+// @Success      200  {object}  utils.APIResponse{data=response.CalendarFeedResponse}
+// @Router       /tickets/me/calendar/rotate [post]
+// @Security     BearerAuth
+func (h *CalendarFeedHandler) RotateTicketFeedURL(c *gin.Context) {
+	userIDStr, exists := c.Get("user_id")
+	if !exists {
+		utils.ErrorResponse(c, http.StatusUnauthorized, "Unauthorized", nil)
+		return
+	}
+	userID, _ := uuid.Parse(userIDStr.(string))
+
+	token, err := h.feedUC.RotateTicketFeedToken(userID)
+	if err != nil {
+		utils.ErrorResponse(c, http.StatusInternalServerError, err.Error(), nil)
+		return
+	}
+	utils.SuccessResponse(c, http.StatusOK, "Calendar feed rotated", ticketFeedURL(c, token))
+}
+
+// ticketFeedURL membangun URL absolut feed dari host request (mendukung reverse proxy via X-Forwarded-Proto)
+func ticketFeedURL(c *gin.Context, token string) response.CalendarFeedResponse {
+	scheme := "http"
+	if c.Request.TLS != nil {
+		scheme = "https"
+	}
+	if proto := c.GetHeader("X-Forwarded-Proto"); proto != "" {
+		scheme = proto
+	}
+	url := scheme + "://" + c.Request.Host + "/api/v1/feeds/tickets/" + token + ".ics"
+	return response.CalendarFeedResponse{
+		URL:       url,
+		WebcalURL: "webcal://" + strings.TrimPrefix(strings.TrimPrefix(url, "https://"), "http://"),
+	}
+}
+
+func writeICS(c *gin.Context, filename string, feed []byte) {
+	c.Header("Content-Disposition", "inline; filename="+filename)
+	c.Data(http.StatusOK, "text/calendar; charset=utf-8", feed)
+}
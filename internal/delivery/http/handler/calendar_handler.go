@@ -0,0 +1,170 @@
+package handler
+
+import (
+	"movie-app/internal/delivery/http/dto/request"
+	_ "movie-app/internal/delivery/http/dto/response"
+	_ "movie-app/internal/domain"
+	"movie-app/internal/usecase"
+	"movie-app/pkg/utils"
+	"movie-app/pkg/validator"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type CalendarHandler struct {
+	calendarUC usecase.CalendarUseCase
+	val        *validator.CustomValidator
+}
+
+func NewCalendarHandler(calendarUC usecase.CalendarUseCase, val *validator.CustomValidator) *CalendarHandler {
+	return &CalendarHandler{calendarUC, val}
+}
+
+// Create godoc
+// @Summary      Create calendar date
+// @Description  Add a holiday or special event date, one-off or recurring yearly (Super admin only)
+// @Tags         Calendar
+// @Accept       json
+// @Produce      json
+// @Param        request body request.CreateCalendarDateRequest true "Calendar Date Data"
+// @Success      201  {object}  utils.APIResponse{data=domain.CalendarDate}
+// @Failure      400  {object}  utils.APIResponse
+// @Router       /calendar [post]
+// @Security     BearerAuth
+func (h *CalendarHandler) Create(c *gin.Context) {
+	var req request.CreateCalendarDateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, "Invalid body", err.Error())
+		return
+	}
+	if err := h.val.Validate(req); err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, "Validation error", validator.FormatError(err))
+		return
+	}
+
+	date, err := h.calendarUC.Create(req)
+	if err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	utils.SuccessResponse(c, http.StatusCreated, "Calendar date created", date)
+}
+
+// GetAll godoc
+// @Summary      Get calendar dates
+// @Description  List holidays and special event dates. Recurring dates are always included (Admin only)
+// @Tags         Calendar
+// @Accept       json
+// @Produce      json
+// @Param        year   query    int     false  "Filter by year"
+// @Success      200    {object} utils.APIResponse{data=[]domain.CalendarDate}
+// @Router       /calendar [get]
+// @Security     BearerAuth
+func (h *CalendarHandler) GetAll(c *gin.Context) {
+	year, _ := strconv.Atoi(c.Query("year"))
+
+	dates, err := h.calendarUC.GetAll(year)
+	if err != nil {
+		utils.ErrorResponse(c, http.StatusInternalServerError, err.Error(), nil)
+		return
+	}
+	utils.SuccessResponse(c, http.StatusOK, "List calendar dates", dates)
+}
+
+// Update godoc
+// @Summary      Update calendar date
+// @Description  Change a holiday or special event date (Super admin only)
+// @Tags         Calendar
+// @Accept       json
+// @Produce      json
+// @Param        id       path    string  true  "Calendar Date UUID"
+// @Param        request  body    request.UpdateCalendarDateRequest true "Update Data"
+// @Success      200      {object} utils.APIResponse{data=domain.CalendarDate}
+// @Failure      400      {object} utils.APIResponse
+// @Router       /calendar/{id} [put]
+// @Security     BearerAuth
+func (h *CalendarHandler) Update(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, "Invalid UUID", nil)
+		return
+	}
+
+	var req request.UpdateCalendarDateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, "Invalid body", err.Error())
+		return
+	}
+	if err := h.val.Validate(req); err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, "Validation error", validator.FormatError(err))
+		return
+	}
+
+	date, err := h.calendarUC.Update(id, req)
+	if err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	utils.SuccessResponse(c, http.StatusOK, "Calendar date updated", date)
+}
+
+// Delete godoc
+// @Summary      Delete calendar date
+// @Description  Remove a holiday or special event date (Super admin only)
+// @Tags         Calendar
+// @Accept       json
+// @Produce      json
+// @Param        id   path      string  true  "Calendar Date UUID"
+// @Success      200  {object}  utils.APIResponse
+// @Router       /calendar/{id} [delete]
+// @Security     BearerAuth
+func (h *CalendarHandler) Delete(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, "Invalid UUID", nil)
+		return
+	}
+
+	if err := h.calendarUC.Delete(id); err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	utils.SuccessResponse(c, http.StatusOK, "Calendar date deleted", nil)
+}
+
+// ImportICal godoc
+// @Summary      Import iCalendar file
+// @Description  Import holidays / events from an .ics file. Re-importing the same file updates existing dates (Super admin only)
+// @Tags         Calendar
+// @Accept       multipart/form-data
+// @Produce      json
+// @Param        file      formData  file    true   "iCalendar (.ics) file"
+// @Param        day_type  formData  string  false  "holiday (default) or special_event"
+// @Success      200  {object}  utils.APIResponse{data=response.CalendarImportResponse}
+// @Failure      400  {object}  utils.APIResponse
+// @Router       /calendar/import [post]
+// @Security     BearerAuth
+func (h *CalendarHandler) ImportICal(c *gin.Context) {
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, "File is required", nil)
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, "Failed to read file", err.Error())
+		return
+	}
+	defer file.Close()
+
+	result, err := h.calendarUC.ImportICal(file, c.PostForm("day_type"))
+	if err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	utils.SuccessResponse(c, http.StatusOK, "Calendar imported", result)
+}
@@ -0,0 +1,236 @@
+package handler
+
+import (
+	"fmt"
+	"movie-app/internal/delivery/http/dto/request"
+	_ "movie-app/internal/delivery/http/dto/response"
+	_ "movie-app/internal/domain"
+	"movie-app/internal/usecase"
+	"movie-app/pkg/utils"
+	"movie-app/pkg/validator"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type CampaignHandler struct {
+	campaignUC usecase.CampaignUseCase
+	val        *validator.CustomValidator
+}
+
+func NewCampaignHandler(campaignUC usecase.CampaignUseCase, val *validator.CustomValidator) *CampaignHandler {
+	return &CampaignHandler{campaignUC, val}
+}
+
+// Create godoc
+// @Summary      Create voucher campaign
+// @Description  Create a campaign that owns a promo definition for single-use voucher codes (Super admin only)
+// @Tags         Campaigns
+// @Accept       json
+// @Produce      json
+// @Param        request body request.CreateCampaignRequest true "Campaign Data"
+// @Success      201  {object}  utils.APIResponse{data=domain.Campaign}
+// @Failure      400  {object}  utils.APIResponse
+// @Router       /campaigns [post]
+// @Security     BearerAuth
+func (h *CampaignHandler) Create(c *gin.Context) {
+	var req request.CreateCampaignRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, "Invalid body", err.Error())
+		return
+	}
+	if err := h.val.Validate(req); err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, "Validation error", validator.FormatError(err))
+		return
+	}
+
+	campaign, err := h.campaignUC.Create(req)
+	if err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	utils.SuccessResponse(c, http.StatusCreated, "Campaign created", campaign)
+}
+
+// GetAll godoc
+// @Summary      Get all campaigns
+// @Description  Get list of voucher campaigns (Admin only)
+// @Tags         Campaigns
+// @Accept       json
+// @Produce      json
+// @Success      200  {object}  utils.APIResponse{data=[]domain.Campaign}
+// @Router       /campaigns [get]
+// @Security     BearerAuth
+func (h *CampaignHandler) GetAll(c *gin.Context) {
+	campaigns, err := h.campaignUC.GetAll()
+	if err != nil {
+		utils.ErrorResponse(c, http.StatusInternalServerError, err.Error(), nil)
+		return
+	}
+	utils.SuccessResponse(c, http.StatusOK, "List campaigns", campaigns)
+}
+
+// GetByID godoc
+// @Summary      Get campaign detail
+// @Description  Get campaign with its voucher code summary (Admin only)
+// @Tags         Campaigns
+// @Accept       json
+// @Produce      json
+// @Param        id   path      string  true  "Campaign UUID"
+// @Success      200  {object}  utils.APIResponse
+// @Failure      404  {object}  utils.APIResponse
+// @Router       /campaigns/{id} [get]
+// @Security     BearerAuth
+func (h *CampaignHandler) GetByID(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, "Invalid UUID", nil)
+		return
+	}
+
+	campaign, summary, err := h.campaignUC.GetByID(id)
+	if err != nil {
+		utils.ErrorResponse(c, http.StatusNotFound, err.Error(), nil)
+		return
+	}
+	utils.SuccessResponse(c, http.StatusOK, "Campaign found", gin.H{
+		"campaign": campaign,
+		"codes":    summary,
+	})
+}
+
+// GenerateCodes godoc
+// @Summary      Generate voucher codes
+// @Description  Generate N random single-use codes for a campaign (Super admin only)
+// @Tags         Campaigns
+// @Accept       json
+// @Produce      json
+// @Param        id       path    string  true  "Campaign UUID"
+// @Param        request  body    request.GenerateVoucherCodesRequest true "Generation options"
+// @Success      201      {object} utils.APIResponse{data=[]string}
+// @Failure      400      {object} utils.APIResponse
+// @Router       /campaigns/{id}/codes [post]
+// @Security     BearerAuth
+func (h *CampaignHandler) GenerateCodes(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, "Invalid UUID", nil)
+		return
+	}
+
+	var req request.GenerateVoucherCodesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, "Invalid body", err.Error())
+		return
+	}
+	if err := h.val.Validate(req); err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, "Validation error", validator.FormatError(err))
+		return
+	}
+
+	codes, err := h.campaignUC.GenerateCodes(id, req)
+	if err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	utils.SuccessResponse(c, http.StatusCreated, fmt.Sprintf("%d voucher codes generated", len(codes)), codes)
+}
+
+// GetCodes godoc
+// @Summary      List voucher codes
+// @Description  List codes of a campaign with who redeemed them (Super admin only)
+// @Tags         Campaigns
+// @Accept       json
+// @Produce      json
+// @Param        id      path     string  true   "Campaign UUID"
+// @Param        status  query    string  false  "unused, redeemed or revoked"
+// @Param        page    query    int     false  "Page number" default(1)
+// @Param        limit   query    int     false  "Limit per page" default(50)
+// @Success      200     {object} utils.APIResponse{data=[]response.VoucherCodeResponse}
+// @Router       /campaigns/{id}/codes [get]
+// @Security     BearerAuth
+func (h *CampaignHandler) GetCodes(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, "Invalid UUID", nil)
+		return
+	}
+
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "50"))
+
+	codes, meta, err := h.campaignUC.GetCodes(id, c.Query("status"), page, limit)
+	if err != nil {
+		utils.ErrorResponse(c, http.StatusNotFound, err.Error(), nil)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  true,
+		"message": "List of voucher codes",
+		"data":    codes,
+		"meta":    meta,
+	})
+}
+
+// ExportCodesCSV godoc
+// @Summary      Export voucher codes CSV
+// @Description  Download all codes of a campaign as CSV (Super admin only)
+// @Tags         Campaigns
+// @Produce      text/csv
+// @Param        id   path     string  true  "Campaign UUID"
+// @Success      200  {file}   file
+// @Router       /campaigns/{id}/codes/export [get]
+// @Security     BearerAuth
+func (h *CampaignHandler) ExportCodesCSV(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, "Invalid UUID", nil)
+		return
+	}
+
+	csvBytes, err := h.campaignUC.ExportCodesCSV(id)
+	if err != nil {
+		utils.ErrorResponse(c, http.StatusInternalServerError, "Failed to generate CSV", err.Error())
+		return
+	}
+
+	filename := fmt.Sprintf("voucher_codes_%s.csv", id)
+	c.Header("Content-Disposition", "attachment; filename="+filename)
+	c.Header("Content-Type", "text/csv")
+	c.Data(http.StatusOK, "text/csv", csvBytes)
+}
+
+// RevokeCodes godoc
+// @Summary      Revoke voucher codes
+// @Description  Revoke unused codes in bulk; an empty list revokes every unused code (Super admin only)
+// @Tags         Campaigns
+// @Accept       json
+// @Produce      json
+// @Param        id       path    string  true  "Campaign UUID"
+// @Param        request  body    request.RevokeVoucherCodesRequest true "Codes to revoke"
+// @Success      200      {object} utils.APIResponse
+// @Router       /campaigns/{id}/codes/revoke [post]
+// @Security     BearerAuth
+func (h *CampaignHandler) RevokeCodes(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, "Invalid UUID", nil)
+		return
+	}
+
+	var req request.RevokeVoucherCodesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, "Invalid body", err.Error())
+		return
+	}
+
+	revoked, err := h.campaignUC.RevokeCodes(id, req)
+	if err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	utils.SuccessResponse(c, http.StatusOK, fmt.Sprintf("%d voucher codes revoked", revoked), gin.H{"revoked": revoked})
+}
@@ -0,0 +1,258 @@
+package handler
+
+import (
+	"movie-app/internal/delivery/http/dto/request"
+	"movie-app/internal/delivery/http/dto/response"
+	"movie-app/internal/domain"
+	"movie-app/internal/usecase"
+	"movie-app/pkg/utils"
+	"movie-app/pkg/validator"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type CinemaHandler struct {
+	cinemaUC usecase.CinemaUseCase
+	val      *validator.CustomValidator
+}
+
+func NewCinemaHandler(cinemaUC usecase.CinemaUseCase, val *validator.CustomValidator) *CinemaHandler {
+	return &CinemaHandler{cinemaUC, val}
+}
+
+// Create godoc
+// @Summary      Create cinema
+// @Description  Create a cinema branch with address, coordinates, opening hours and contact (Admin only)
+// @Tags         Cinemas
+// @Accept       json
+// @Produce      json
+// @Param        request body request.CreateCinemaRequest true "Cinema Data"
+// @Success      201  {object}  utils.APIResponse{data=response.CinemaResponse}
+// @Failure      400  {object}  utils.APIResponse
+// @Router       /cinemas [post]
+// @Security     BearerAuth
+func (h *CinemaHandler) Create(c *gin.Context) {
+	var req request.CreateCinemaRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, "Invalid body", err.Error())
+		return
+	}
+
+	if err := h.val.Validate(req); err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, "Validation error", validator.FormatError(err))
+		return
+	}
+
+	cinema, err := h.cinemaUC.Create(req)
+	if err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	utils.SuccessResponse(c, http.StatusCreated, "Cinema created", h.mapResponse(cinema))
+}
+
+// GetAll godoc
+// @Summary      Get all cinemas
+// @Description  List active cinemas, optionally filtered by city
+// @Tags         Cinemas
+// @Produce      json
+// @Param        city   query    string  false  "City (case-insensitive)"
+// @Param        page   query    int     false  "Page number" default(1)
+// @Param        limit  query    int     false  "Limit per page" default(10)
+// @Success      200    {object} utils.APIResponse{data=[]response.CinemaResponse}
+// @Router       /cinemas [get]
+func (h *CinemaHandler) GetAll(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
+
+	cinemas, meta, err := h.cinemaUC.GetAll(c.Query("city"), true, page, limit)
+	if err != nil {
+		utils.ErrorResponse(c, http.StatusInternalServerError, err.Error(), nil)
+		return
+	}
+
+	res := []response.CinemaResponse{}
+	for i := range cinemas {
+		res = append(res, h.mapResponse(&cinemas[i]))
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  true,
+		"message": "List of cinemas",
+		"data":    res,
+		"meta":    meta,
+	})
+}
+
+// Nearby godoc
+// @Summary      Search nearby cinemas
+// @Description  Active cinemas within radius_km of a point, nearest first. Distance is computed in the database (haversine).
+// @Tags         Cinemas
+// @Produce      json
+// @Param        lat        query    number  true   "Latitude"
+// @Param        lng        query    number  true   "Longitude"
+// @Param        radius_km  query    number  false  "Search radius in km" default(25)
+// @Param        limit      query    int     false  "Max results" default(20)
+// @Success      200        {object} utils.APIResponse{data=[]response.CinemaResponse}
+// @Failure      400        {object} utils.APIResponse
+// @Router       /cinemas/nearby [get]
+func (h *CinemaHandler) Nearby(c *gin.Context) {
+	var req request.NearbyCinemaRequest
+	var errLat, errLng error
+	req.Lat, errLat = strconv.ParseFloat(c.Query("lat"), 64)
+	req.Lng, errLng = strconv.ParseFloat(c.Query("lng"), 64)
+	if errLat != nil || errLng != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, "lat and lng are required numbers", nil)
+		return
+	}
+	if v := c.Query("radius_km"); v != "" {
+		radius, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			utils.ErrorResponse(c, http.StatusBadRequest, "invalid radius_km", nil)
+			return
+		}
+		req.RadiusKm = radius
+	}
+	if v := c.Query("limit"); v != "" {
+		limit, err := strconv.Atoi(v)
+		if err != nil {
+			utils.ErrorResponse(c, http.StatusBadRequest, "invalid limit", nil)
+			return
+		}
+		req.Limit = limit
+	}
+
+	if err := h.val.Validate(req); err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, "Validation error", validator.FormatError(err))
+		return
+	}
+
+	cinemas, err := h.cinemaUC.Nearby(req)
+	if err != nil {
+		utils.ErrorResponse(c, http.StatusInternalServerError, err.Error(), nil)
+		return
+	}
+
+	res := []response.CinemaResponse{}
+	for i := range cinemas {
+		item := h.mapResponse(&cinemas[i])
+		distance := cinemas[i].DistanceKm
+		item.DistanceKm = &distance
+		res = append(res, item)
+	}
+	utils.SuccessResponse(c, http.StatusOK, "Nearby cinemas", res)
+}
+
+// GetByID godoc
+// @Summary      Get cinema by ID
+// @Description  Cinema details including its studios
+// @Tags         Cinemas
+// @Produce      json
+// @Param        id   path      string  true  "Cinema UUID"
+// @Success      200  {object}  utils.APIResponse{data=response.CinemaResponse}
+// @Failure      404  {object}  utils.APIResponse
+// @Router       /cinemas/{id} [get]
+func (h *CinemaHandler) GetByID(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, "Invalid UUID", nil)
+		return
+	}
+
+	cinema, err := h.cinemaUC.GetByID(id)
+	if err != nil {
+		utils.ErrorResponse(c, http.StatusNotFound, err.Error(), nil)
+		return
+	}
+
+	res := h.mapResponse(cinema)
+	for _, s := range cinema.Studios {
+		res.Studios = append(res.Studios, response.StudioResponse{ID: s.ID, CinemaID: s.CinemaID, Name: s.Name, Capacity: s.Capacity, CleaningMinutes: s.CleaningMinutes, Timezone: s.Timezone, Features: s.Features})
+	}
+	utils.SuccessResponse(c, http.StatusOK, "Cinema found", res)
+}
+
+// Update godoc
+// @Summary      Update cinema
+// @Description  Update cinema details (Admin only)
+// @Tags         Cinemas
+// @Accept       json
+// @Produce      json
+// @Param        id       path    string  true  "Cinema UUID"
+// @Param        request  body    request.UpdateCinemaRequest true "Update Data"
+// @Success      200      {object} utils.APIResponse{data=response.CinemaResponse}
+// @Failure      400      {object} utils.APIResponse
+// @Router       /cinemas/{id} [put]
+// @Security     BearerAuth
+func (h *CinemaHandler) Update(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, "Invalid UUID", nil)
+		return
+	}
+
+	var req request.UpdateCinemaRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, "Invalid body", err.Error())
+		return
+	}
+
+	if err := h.val.Validate(req); err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, "Validation error", validator.FormatError(err))
+		return
+	}
+
+	cinema, err := h.cinemaUC.Update(id, req)
+	if err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	utils.SuccessResponse(c, http.StatusOK, "Cinema updated", h.mapResponse(cinema))
+}
+
+// Delete godoc
+// @Summary      Delete cinema
+// @Description  Delete a cinema without studios (Admin only)
+// @Tags         Cinemas
+// @Produce      json
+// @Param        id   path      string  true  "Cinema UUID"
+// @Success      200  {object}  utils.APIResponse
+// @Failure      400  {object}  utils.APIResponse
+// @Router       /cinemas/{id} [delete]
+// @Security     BearerAuth
+func (h *CinemaHandler) Delete(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, "Invalid UUID", nil)
+		return
+	}
+
+	if err := h.cinemaUC.Delete(id); err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	utils.SuccessResponse(c, http.StatusOK, "Cinema deleted", nil)
+}
+
+func (h *CinemaHandler) mapResponse(cinema *domain.Cinema) response.CinemaResponse {
+	return response.CinemaResponse{
+		ID:          cinema.ID,
+		Name:        cinema.Name,
+		Address:     cinema.Address,
+		City:        cinema.City,
+		Latitude:    cinema.Latitude,
+		Longitude:   cinema.Longitude,
+		Timezone:    cinema.Timezone,
+		OpeningTime: cinema.OpeningTime,
+		ClosingTime: cinema.ClosingTime,
+		Phone:       cinema.Phone,
+		Email:       cinema.Email,
+		IsActive:    cinema.IsActive,
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"movie-app/pkg/validator"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -25,7 +26,7 @@ func NewMovieHandler(movieUC usecase.MovieUseCase, val *validator.CustomValidato
 
 // Create godoc
 // @Summary      Create new movie
-// @Description  Add a new movie (Admin only)
+// @Description  Add a new movie (Super admin only)
 // @Tags         Movies
 // @Accept       json
 // @Produce      json
@@ -57,22 +58,36 @@ func (h *MovieHandler) Create(c *gin.Context) {
 
 // GetAll godoc
 // @Summary      Get all movies
-// @Description  Get list of movies (Public)
+// @Description  Get list of movies (Public), filterable by age rating, language and release window
 // @Tags         Movies
 // @Accept       json
 // @Produce      json
-// @Param        page   query    int     false  "Page number" default(1)
-// @Param        limit  query    int     false  "Limit per page" default(10)
+// @Param        search             query    string  false  "Title or genre"
+// @Param        age_rating         query    string  false  "Comma-separated: SU,13+,17+,21+"
+// @Param        language           query    string  false  "Original language (e.g. en, id)"
+// @Param        subtitle_language  query    string  false  "Has subtitles in this language"
+// @Param        released_from      query    string  false  "Release date from (YYYY-MM-DD)"
+// @Param        released_to        query    string  false  "Release date to (YYYY-MM-DD)"
+// @Param        page               query    int     false  "Page number" default(1)
+// @Param        limit              query    int     false  "Limit per page" default(10)
 // @Success      200    {object} utils.APIResponse{data=[]response.MovieResponse}
+// @Failure      400    {object} utils.APIResponse
 // @Router       /movies [get]
 func (h *MovieHandler) GetAll(c *gin.Context) {
 	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
 	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
-	search := c.Query("search") // Ambil query params ?search=...
+	filter := request.MovieFilterRequest{
+		Search:           c.Query("search"), // Ambil query params ?search=...
+		AgeRating:        c.Query("age_rating"),
+		Language:         c.Query("language"),
+		SubtitleLanguage: c.Query("subtitle_language"),
+		ReleasedFrom:     c.Query("released_from"),
+		ReleasedTo:       c.Query("released_to"),
+	}
 
-	movies, meta, err := h.movieUC.GetAll(page, limit, search)
+	movies, meta, err := h.movieUC.GetAll(filter, page, limit)
 	if err != nil {
-		utils.ErrorResponse(c, http.StatusInternalServerError, err.Error(), nil)
+		utils.ErrorResponse(c, http.StatusBadRequest, err.Error(), nil)
 		return
 	}
 
@@ -116,6 +131,10 @@ func (h *MovieHandler) Update(c *gin.Context) {
 		utils.ErrorResponse(c, http.StatusBadRequest, "Invalid body", err.Error())
 		return
 	}
+	if err := h.val.Validate(req); err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, "Validation error", validator.FormatError(err))
+		return
+	}
 
 	movie, err := h.movieUC.Update(id, req)
 	if err != nil {
@@ -148,5 +167,22 @@ func (h *MovieHandler) mapResponse(m *domain.Movie) response.MovieResponse {
 		Duration:    m.Duration,
 		Genre:       m.Genre,
 		PosterURL:   m.PosterURL,
+
+		AgeRating:         m.AgeRating,
+		ReleaseDate:       formatMovieDate(m.ReleaseDate),
+		EndOfRunDate:      formatMovieDate(m.EndOfRunDate),
+		Director:          m.Director,
+		Cast:              m.Cast,
+		OriginalLanguage:  m.OriginalLanguage,
+		SubtitleLanguages: m.SubtitleLanguages,
+		TrailerURL:        m.TrailerURL,
+		BackdropURL:       m.BackdropURL,
+	}
+}
+
+func formatMovieDate(t *time.Time) string {
+	if t == nil {
+		return ""
 	}
+	return t.Format("2006-01-02")
 }
@@ -0,0 +1,161 @@
+package handler
+
+import (
+	"movie-app/internal/delivery/http/dto/request"
+	_ "movie-app/internal/delivery/http/dto/response"
+	_ "movie-app/internal/domain"
+	"movie-app/internal/usecase"
+	"movie-app/pkg/utils"
+	"movie-app/pkg/validator"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type PaymentMethodHandler struct {
+	methodUC usecase.PaymentMethodUseCase
+	val      *validator.CustomValidator
+}
+
+func NewPaymentMethodHandler(methodUC usecase.PaymentMethodUseCase, val *validator.CustomValidator) *PaymentMethodHandler {
+	return &PaymentMethodHandler{methodUC, val}
+}
+
+// Create godoc
+// @Summary      Create payment method
+// @Description  Add a new payment method to the catalog (Super admin only)
+// @Tags         Payment Methods
+// @Accept       json
+// @Produce      json
+// @Param        request body request.CreatePaymentMethodRequest true "Payment Method Data"
+// @Success      201  {object}  utils.APIResponse{data=domain.PaymentMethod}
+// @Failure      400  {object}  utils.APIResponse
+// @Router       /payment-methods [post]
+// @Security     BearerAuth
+func (h *PaymentMethodHandler) Create(c *gin.Context) {
+	var req request.CreatePaymentMethodRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, "Invalid body", err.Error())
+		return
+	}
+	if err := h.val.Validate(req); err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, "Validation error", validator.FormatError(err))
+		return
+	}
+
+	method, err := h.methodUC.Create(req)
+	if err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	utils.SuccessResponse(c, http.StatusCreated, "Payment method created", method)
+}
+
+// GetAll godoc
+// @Summary      Get all payment methods
+// @Description  Get the full payment method catalog, including disabled ones (Admin only)
+// @Tags         Payment Methods
+// @Accept       json
+// @Produce      json
+// @Success      200  {object}  utils.APIResponse{data=[]domain.PaymentMethod}
+// @Router       /payment-methods [get]
+// @Security     BearerAuth
+func (h *PaymentMethodHandler) GetAll(c *gin.Context) {
+	methods, err := h.methodUC.GetAll()
+	if err != nil {
+		utils.ErrorResponse(c, http.StatusInternalServerError, err.Error(), nil)
+		return
+	}
+	utils.SuccessResponse(c, http.StatusOK, "List payment methods", methods)
+}
+
+// Update godoc
+// @Summary      Update payment method
+// @Description  Enable/disable or change limits, fees and availability (Super admin only)
+// @Tags         Payment Methods
+// @Accept       json
+// @Produce      json
+// @Param        id       path    string  true  "Payment Method UUID"
+// @Param        request  body    request.UpdatePaymentMethodRequest true "Update Data"
+// @Success      200      {object} utils.APIResponse{data=domain.PaymentMethod}
+// @Failure      400      {object} utils.APIResponse
+// @Router       /payment-methods/{id} [put]
+// @Security     BearerAuth
+func (h *PaymentMethodHandler) Update(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, "Invalid UUID", nil)
+		return
+	}
+
+	var req request.UpdatePaymentMethodRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, "Invalid body", err.Error())
+		return
+	}
+	if err := h.val.Validate(req); err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, "Validation error", validator.FormatError(err))
+		return
+	}
+
+	method, err := h.methodUC.Update(id, req)
+	if err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	utils.SuccessResponse(c, http.StatusOK, "Payment method updated", method)
+}
+
+// Delete godoc
+// @Summary      Delete payment method
+// @Description  Remove a payment method from the catalog (Super admin only)
+// @Tags         Payment Methods
+// @Accept       json
+// @Produce      json
+// @Param        id   path      string  true  "Payment Method UUID"
+// @Success      200  {object}  utils.APIResponse
+// @Router       /payment-methods/{id} [delete]
+// @Security     BearerAuth
+func (h *PaymentMethodHandler) Delete(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, "Invalid UUID", nil)
+		return
+	}
+
+	if err := h.methodUC.Delete(id); err != nil {
+		utils.ErrorResponse(c, http.StatusInternalServerError, err.Error(), nil)
+		return
+	}
+	utils.SuccessResponse(c, http.StatusOK, "Payment method deleted", nil)
+}
+
+// GetAvailableForTransaction godoc
+// @Summary      Get payment methods for a transaction
+// @Description  List payment methods valid for the amount and location of a pending transaction
+// @Tags         Transactions
+// @Accept       json
+// @Produce      json
+// @Param        id   path      string  true  "Transaction UUID"
+// @Success      200  {object}  utils.APIResponse{data=[]response.PaymentMethodResponse}
+// @Failure      400  {object}  utils.APIResponse
+// @Router       /transactions/{id}/payment-methods [get]
+// @Security     BearerAuth
+func (h *PaymentMethodHandler) GetAvailableForTransaction(c *gin.Context) {
+	userIDStr, _ := c.Get("user_id")
+	userID, _ := uuid.Parse(userIDStr.(string))
+
+	transactionID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, "Invalid UUID", nil)
+		return
+	}
+
+	methods, err := h.methodUC.GetAvailableForTransaction(userID, transactionID)
+	if err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	utils.SuccessResponse(c, http.StatusOK, "Available payment methods", methods)
+}
@@ -0,0 +1,158 @@
+package handler
+
+import (
+	"movie-app/internal/delivery/http/dto/request"
+	_ "movie-app/internal/delivery/http/dto/response"
+	_ "movie-app/internal/domain"
+	"movie-app/internal/usecase"
+	"movie-app/pkg/utils"
+	"movie-app/pkg/validator"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type PricingRuleHandler struct {
+	ruleUC usecase.PricingRuleUseCase
+	val    *validator.CustomValidator
+}
+
+func NewPricingRuleHandler(ruleUC usecase.PricingRuleUseCase, val *validator.CustomValidator) *PricingRuleHandler {
+	return &PricingRuleHandler{ruleUC, val}
+}
+
+// Create godoc
+// @Summary      Create pricing rule
+// @Description  Add a rule that adjusts seat prices by day, time band, movie or seat category (Super admin only)
+// @Tags         Pricing Rules
+// @Accept       json
+// @Produce      json
+// @Param        request body request.CreatePricingRuleRequest true "Pricing Rule Data"
+// @Success      201  {object}  utils.APIResponse{data=domain.PricingRule}
+// @Failure      400  {object}  utils.APIResponse
+// @Router       /pricing-rules [post]
+// @Security     BearerAuth
+func (h *PricingRuleHandler) Create(c *gin.Context) {
+	var req request.CreatePricingRuleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, "Invalid body", err.Error())
+		return
+	}
+	if err := h.val.Validate(req); err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, "Validation error", validator.FormatError(err))
+		return
+	}
+
+	rule, err := h.ruleUC.Create(req)
+	if err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	utils.SuccessResponse(c, http.StatusCreated, "Pricing rule created", rule)
+}
+
+// GetAll godoc
+// @Summary      Get all pricing rules
+// @Description  Get all pricing rules in evaluation order (Admin only)
+// @Tags         Pricing Rules
+// @Accept       json
+// @Produce      json
+// @Success      200  {object}  utils.APIResponse{data=[]domain.PricingRule}
+// @Router       /pricing-rules [get]
+// @Security     BearerAuth
+func (h *PricingRuleHandler) GetAll(c *gin.Context) {
+	rules, err := h.ruleUC.GetAll()
+	if err != nil {
+		utils.ErrorResponse(c, http.StatusInternalServerError, err.Error(), nil)
+		return
+	}
+	utils.SuccessResponse(c, http.StatusOK, "List pricing rules", rules)
+}
+
+// Update godoc
+// @Summary      Update pricing rule
+// @Description  Change conditions, priority or adjustment of a pricing rule (Super admin only)
+// @Tags         Pricing Rules
+// @Accept       json
+// @Produce      json
+// @Param        id       path    string  true  "Pricing Rule UUID"
+// @Param        request  body    request.UpdatePricingRuleRequest true "Update Data"
+// @Success      200      {object} utils.APIResponse{data=domain.PricingRule}
+// @Failure      400      {object} utils.APIResponse
+// @Router       /pricing-rules/{id} [put]
+// @Security     BearerAuth
+func (h *PricingRuleHandler) Update(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, "Invalid UUID", nil)
+		return
+	}
+
+	var req request.UpdatePricingRuleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, "Invalid body", err.Error())
+		return
+	}
+	if err := h.val.Validate(req); err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, "Validation error", validator.FormatError(err))
+		return
+	}
+
+	rule, err := h.ruleUC.Update(id, req)
+	if err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	utils.SuccessResponse(c, http.StatusOK, "Pricing rule updated", rule)
+}
+
+// Delete godoc
+// @Summary      Delete pricing rule
+// @Description  Remove a pricing rule (Super admin only)
+// @Tags         Pricing Rules
+// @Accept       json
+// @Produce      json
+// @Param        id   path      string  true  "Pricing Rule UUID"
+// @Success      200  {object}  utils.APIResponse
+// @Router       /pricing-rules/{id} [delete]
+// @Security     BearerAuth
+func (h *PricingRuleHandler) Delete(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, "Invalid UUID", nil)
+		return
+	}
+
+	if err := h.ruleUC.Delete(id); err != nil {
+		utils.ErrorResponse(c, http.StatusInternalServerError, err.Error(), nil)
+		return
+	}
+	utils.SuccessResponse(c, http.StatusOK, "Pricing rule deleted", nil)
+}
+
+// PreviewSchedule godoc
+// @Summary      Preview schedule pricing
+// @Description  Show the final price per seat category of a schedule and which pricing rules fired (Admin only)
+// @Tags         Schedules
+// @Accept       json
+// @Produce      json
+// @Param        id   path      string  true  "Schedule UUID"
+// @Success      200  {object}  utils.APIResponse{data=response.PricingPreviewResponse}
+// @Failure      404  {object}  utils.APIResponse
+// @Router       /schedules/{id}/pricing-preview [get]
+// @Security     BearerAuth
+func (h *PricingRuleHandler) PreviewSchedule(c *gin.Context) {
+	scheduleID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, "Invalid UUID", nil)
+		return
+	}
+
+	preview, err := h.ruleUC.PreviewSchedule(scheduleID)
+	if err != nil {
+		utils.ErrorResponse(c, http.StatusNotFound, err.Error(), nil)
+		return
+	}
+	utils.SuccessResponse(c, http.StatusOK, "Pricing preview", preview)
+}
@@ -3,6 +3,7 @@ package handler
 import (
 	"movie-app/internal/delivery/http/dto/request"
 	_ "movie-app/internal/domain"
+	"movie-app/internal/enums"
 	"movie-app/internal/usecase"
 	"movie-app/pkg/utils"
 	"net/http"
@@ -21,7 +22,7 @@ func NewPromoHandler(promoUC usecase.PromoUseCase) *PromoHandler {
 
 // Create godoc
 // @Summary      Create new promo
-// @Description  Add a new promo code (Admin only)
+// @Description  Add a new promo code (Super admin only)
 // @Tags         Promos
 // @Accept       json
 // @Produce      json
@@ -37,9 +38,9 @@ func (h *PromoHandler) Create(c *gin.Context) {
 		return
 	}
 
-	promo, err := h.promoUC.CreatePromo(req.Code, req.DiscountType, req.DiscountValue, req.ValidUntil)
+	promo, err := h.promoUC.CreatePromo(req)
 	if err != nil {
-		utils.ErrorResponse(c, http.StatusInternalServerError, err.Error(), nil)
+		utils.ErrorResponse(c, http.StatusBadRequest, err.Error(), nil)
 		return
 	}
 	utils.SuccessResponse(c, http.StatusCreated, "Promo created", promo)
@@ -65,7 +66,7 @@ func (h *PromoHandler) GetAll(c *gin.Context) {
 
 // Update godoc
 // @Summary      Update promo
-// @Description  Update promo details (Admin only)
+// @Description  Update promo details (Super admin only)
 // @Tags         Promos
 // @Accept       json
 // @Produce      json
@@ -87,7 +88,7 @@ func (h *PromoHandler) Update(c *gin.Context) {
 		return
 	}
 
-	promo, err := h.promoUC.UpdatePromo(id, req.Code, req.DiscountType, req.DiscountValue, req.ValidUntil)
+	promo, err := h.promoUC.UpdatePromo(id, req)
 	if err != nil {
 		utils.ErrorResponse(c, http.StatusInternalServerError, err.Error(), nil)
 		return
@@ -98,7 +99,7 @@ func (h *PromoHandler) Update(c *gin.Context) {
 
 // Delete godoc
 // @Summary      Delete promo
-// @Description  Delete/Remove a promo (Admin only)
+// @Description  Delete/Remove a promo (Super admin only)
 // @Tags         Promos
 // @Accept       json
 // @Produce      json
@@ -120,3 +121,41 @@ func (h *PromoHandler) Delete(c *gin.Context) {
 
 	utils.SuccessResponse(c, http.StatusOK, "Promo deleted", nil)
 }
+
+// GetRedemptions godoc
+// @Summary      Get promo redemptions
+// @Description  List redemptions of a promo with counts per state: reserved, consumed, released (Super admin only)
+// @Tags         Promos
+// @Accept       json
+// @Produce      json
+// @Param        id      path     string  true   "Promo UUID"
+// @Param        status  query    string  false  "reserved, consumed or released"
+// @Success      200     {object} utils.APIResponse{data=[]domain.PromoRedemption}
+// @Router       /promos/{id}/redemptions [get]
+// @Security     BearerAuth
+func (h *PromoHandler) GetRedemptions(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, "Invalid UUID", nil)
+		return
+	}
+
+	status := c.Query("status")
+	if status != "" && status != enums.RedemptionReserved && status != enums.RedemptionConsumed && status != enums.RedemptionReleased {
+		utils.ErrorResponse(c, http.StatusBadRequest, "Invalid status filter", nil)
+		return
+	}
+
+	redemptions, counts, err := h.promoUC.GetRedemptions(id, status)
+	if err != nil {
+		utils.ErrorResponse(c, http.StatusNotFound, err.Error(), nil)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  true,
+		"message": "List of promo redemptions",
+		"data":    redemptions,
+		"meta":    counts,
+	})
+}
@@ -3,6 +3,7 @@ package handler
 import (
 	"fmt"
 	_ "movie-app/internal/delivery/http/dto/response"
+	"movie-app/internal/delivery/http/middleware"
 	"movie-app/internal/usecase"
 	"movie-app/pkg/utils"
 	"net/http"
@@ -22,7 +23,7 @@ func NewReportHandler(reportUC usecase.ReportUseCase) *ReportHandler {
 func (h *ReportHandler) GetTopMovies(c *gin.Context) {
 	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "5"))
 
-	data, err := h.reportUC.GetTopMovies(limit)
+	data, err := h.reportUC.GetTopMovies(middleware.AdminScopeFromContext(c), limit)
 	if err != nil {
 		utils.ErrorResponse(c, http.StatusInternalServerError, err.Error(), nil)
 		return
@@ -30,6 +31,42 @@ func (h *ReportHandler) GetTopMovies(c *gin.Context) {
 	utils.SuccessResponse(c, http.StatusOK, "Top movies report", data)
 }
 
+// GetTicketTypeSales godoc
+// @Summary      Get ticket type sales
+// @Description  Tickets sold and revenue per ticket type (Admin Only)
+// @Tags         Reports
+// @Accept       json
+// @Produce      json
+// @Success      200    {object} utils.APIResponse{data=[]response.TicketTypeSalesResponse}
+// @Router       /reports/ticket-types [get]
+// @Security     BearerAuth
+func (h *ReportHandler) GetTicketTypeSales(c *gin.Context) {
+	data, err := h.reportUC.GetTicketTypeSales(middleware.AdminScopeFromContext(c))
+	if err != nil {
+		utils.ErrorResponse(c, http.StatusInternalServerError, err.Error(), nil)
+		return
+	}
+	utils.SuccessResponse(c, http.StatusOK, "Ticket type sales report", data)
+}
+
+// GetDayTypeRevenue godoc
+// @Summary      Get holiday vs non-holiday revenue
+// @Description  Tickets sold and revenue grouped by calendar day type of the showtime: holiday, special_event, regular (Admin Only)
+// @Tags         Reports
+// @Accept       json
+// @Produce      json
+// @Success      200    {object} utils.APIResponse{data=[]response.DayTypeRevenueResponse}
+// @Router       /reports/revenue/day-types [get]
+// @Security     BearerAuth
+func (h *ReportHandler) GetDayTypeRevenue(c *gin.Context) {
+	data, err := h.reportUC.GetDayTypeRevenue(middleware.AdminScopeFromContext(c))
+	if err != nil {
+		utils.ErrorResponse(c, http.StatusInternalServerError, err.Error(), nil)
+		return
+	}
+	utils.SuccessResponse(c, http.StatusOK, "Day type revenue report", data)
+}
+
 // GetRevenueReport godoc
 // @Summary      Get revenue report
 // @Description  See daily or monthly revenue (Admin Only)
@@ -42,7 +79,7 @@ func (h *ReportHandler) GetTopMovies(c *gin.Context) {
 // @Security     BearerAuth
 func (h *ReportHandler) GetRevenueReport(c *gin.Context) {
 	mode := c.Query("mode") // day atau month
-	data, err := h.reportUC.GetRevenueReport(mode)
+	data, err := h.reportUC.GetRevenueReport(middleware.AdminScopeFromContext(c), mode)
 	if err != nil {
 		utils.ErrorResponse(c, http.StatusInternalServerError, err.Error(), nil)
 		return
@@ -65,7 +102,7 @@ func (h *ReportHandler) ExportRevenueCSV(c *gin.Context) {
 		mode = "day"
 	}
 
-	csvBytes, err := h.reportUC.GenerateRevenueCSV(mode)
+	csvBytes, err := h.reportUC.GenerateRevenueCSV(middleware.AdminScopeFromContext(c), mode)
 	if err != nil {
 		utils.ErrorResponse(c, http.StatusInternalServerError, "Failed to generate CSV", err.Error())
 		return
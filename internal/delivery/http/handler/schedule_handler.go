@@ -9,6 +9,7 @@ import (
 	"movie-app/pkg/validator"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -16,11 +17,12 @@ import (
 
 type ScheduleHandler struct {
 	scheduleUC usecase.ScheduleUseCase
+	scopeUC    usecase.AdminScopeUseCase
 	val        *validator.CustomValidator
 }
 
-func NewScheduleHandler(scheduleUC usecase.ScheduleUseCase, val *validator.CustomValidator) *ScheduleHandler {
-	return &ScheduleHandler{scheduleUC, val}
+func NewScheduleHandler(scheduleUC usecase.ScheduleUseCase, scopeUC usecase.AdminScopeUseCase, val *validator.CustomValidator) *ScheduleHandler {
+	return &ScheduleHandler{scheduleUC, scopeUC, val}
 }
 
 // Create godoc
@@ -47,6 +49,10 @@ func (h *ScheduleHandler) Create(c *gin.Context) {
 		return
 	}
 
+	if !requireBodyScope(c, h.scopeUC.CheckStudio, req.StudioID) {
+		return
+	}
+
 	schedule, err := h.scheduleUC.Create(req)
 	if err != nil {
 		utils.ErrorResponse(c, http.StatusConflict, err.Error(), nil)
@@ -59,11 +65,12 @@ func (h *ScheduleHandler) Create(c *gin.Context) {
 
 // Update godoc
 // @Summary      Update schedule
-// @Description  Update existing schedule details (Admin only)
+// @Description  Update existing schedule details. Changing the studio of a schedule with sold tickets requires seat_mapping or auto_map_seats; ticket holders are emailed when time or studio changes. Use dry_run=true to get response.ScheduleUpdateImpactResponse without saving (Admin only)
 // @Tags         Schedules
 // @Accept       json
 // @Produce      json
 // @Param        id       path    string  true  "Schedule UUID"
+// @Param        dry_run  query   bool    false "Only preview the impact on sold tickets" default(false)
 // @Param        request  body    request.UpdateScheduleRequest true "Update Data"
 // @Success      200      {object} utils.APIResponse{data=response.ScheduleResponse}
 // @Failure      400      {object} utils.APIResponse
@@ -88,9 +95,24 @@ func (h *ScheduleHandler) Update(c *gin.Context) {
 		return
 	}
 
+	// Pindah studio hanya ke studio dalam cinema admin
+	if !requireBodyScope(c, h.scopeUC.CheckStudio, req.StudioID) {
+		return
+	}
+
+	if dryRun, _ := strconv.ParseBool(c.DefaultQuery("dry_run", "false")); dryRun {
+		impact, err := h.scheduleUC.PreviewUpdate(id, req)
+		if err != nil {
+			utils.ErrorResponse(c, http.StatusBadRequest, err.Error(), nil)
+			return
+		}
+		utils.SuccessResponse(c, http.StatusOK, "Schedule update preview", impact)
+		return
+	}
+
 	schedule, err := h.scheduleUC.Update(id, req)
 	if err != nil {
-		utils.ErrorResponse(c, http.StatusInternalServerError, err.Error(), nil)
+		utils.ErrorResponse(c, http.StatusBadRequest, err.Error(), nil)
 		return
 	}
 
@@ -100,21 +122,88 @@ func (h *ScheduleHandler) Update(c *gin.Context) {
 
 // GetAll godoc
 // @Summary      Get all schedules
-// @Description  Get list of schedules with pagination (Public)
+// @Description  Get list of upcoming schedules ordered by start time, with filters and seat availability (Public)
 // @Tags         Schedules
 // @Accept       json
 // @Produce      json
-// @Param        page   query    int     false  "Page number" default(1)
-// @Param        limit  query    int     false  "Limit per page" default(10)
+// @Param        page          query    int     false  "Page number" default(1)
+// @Param        limit         query    int     false  "Limit per page" default(10)
+// @Param        date          query    string  false  "Show date (YYYY-MM-DD)"
+// @Param        date_from     query    string  false  "Start of date range (YYYY-MM-DD)"
+// @Param        date_to       query    string  false  "End of date range, inclusive (YYYY-MM-DD)"
+// @Param        movie_id      query    string  false  "Movie UUID"
+// @Param        studio_id     query    string  false  "Studio UUID"
+// @Param        cinema_id     query    string  false  "Cinema UUID (all its studios)"
+// @Param        min_price     query    number  false  "Minimum base price (before pricing rules; seat prices at booking may differ)"
+// @Param        max_price     query    number  false  "Maximum base price (before pricing rules; seat prices at booking may differ)"
+// @Param        include_past  query    bool    false  "Include shows that already started" default(false)
+// @Param        format             query    string  false  "Screening format: 2d or 3d"
+// @Param        feature            query    string  false  "Screening feature, e.g. imax, 4dx, dolby_atmos"
+// @Param        audio_language     query    string  false  "Audio language tag, e.g. en"
+// @Param        subtitle_language  query    string  false  "Subtitle language tag, e.g. id"
 // @Success      200    {object} utils.APIResponse{data=[]response.ScheduleResponse}
+// @Failure      400    {object} utils.APIResponse
 // @Router       /schedules [get]
 func (h *ScheduleHandler) GetAll(c *gin.Context) {
+	h.listSchedules(c, scheduleFilterFromQuery(c))
+}
+
+// GetByCinema godoc
+// @Summary      Get cinema showtimes
+// @Description  Upcoming showtimes in all studios of a cinema. Accepts the same filters as GET /schedules; dates use the cinema's timezone.
+// @Tags         Cinemas
+// @Produce      json
+// @Param        id            path     string  true   "Cinema UUID"
+// @Param        page          query    int     false  "Page number" default(1)
+// @Param        limit         query    int     false  "Limit per page" default(10)
+// @Param        date          query    string  false  "Show date (YYYY-MM-DD)"
+// @Param        movie_id      query    string  false  "Movie UUID"
+// @Param        include_past  query    bool    false  "Include shows that already started" default(false)
+// @Param        format             query    string  false  "Screening format: 2d or 3d"
+// @Param        feature            query    string  false  "Screening feature, e.g. imax, 4dx, dolby_atmos"
+// @Param        audio_language     query    string  false  "Audio language tag, e.g. en"
+// @Param        subtitle_language  query    string  false  "Subtitle language tag, e.g. id"
+// @Success      200    {object} utils.APIResponse{data=[]response.ScheduleResponse}
+// @Failure      400    {object} utils.APIResponse
+// @Router       /cinemas/{id}/schedules [get]
+func (h *ScheduleHandler) GetByCinema(c *gin.Context) {
+	if _, err := uuid.Parse(c.Param("id")); err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, "Invalid UUID", nil)
+		return
+	}
+
+	filter := scheduleFilterFromQuery(c)
+	filter.CinemaID = c.Param("id")
+	h.listSchedules(c, filter)
+}
+
+func scheduleFilterFromQuery(c *gin.Context) request.ScheduleFilterRequest {
+	includePast, _ := strconv.ParseBool(c.DefaultQuery("include_past", "false"))
+	return request.ScheduleFilterRequest{
+		Date:        c.Query("date"),
+		DateFrom:    c.Query("date_from"),
+		DateTo:      c.Query("date_to"),
+		MovieID:     c.Query("movie_id"),
+		StudioID:    c.Query("studio_id"),
+		CinemaID:    c.Query("cinema_id"),
+		MinPrice:    c.Query("min_price"),
+		MaxPrice:    c.Query("max_price"),
+		IncludePast: includePast,
+
+		Format:           c.Query("format"),
+		Feature:          c.Query("feature"),
+		AudioLanguage:    c.Query("audio_language"),
+		SubtitleLanguage: c.Query("subtitle_language"),
+	}
+}
+
+func (h *ScheduleHandler) listSchedules(c *gin.Context, filter request.ScheduleFilterRequest) {
 	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
 	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
 
-	schedules, meta, err := h.scheduleUC.GetAll(page, limit)
+	schedules, meta, err := h.scheduleUC.GetAll(filter, page, limit)
 	if err != nil {
-		utils.ErrorResponse(c, http.StatusInternalServerError, err.Error(), nil)
+		utils.ErrorResponse(c, http.StatusBadRequest, err.Error(), nil)
 		return
 	}
 
@@ -150,22 +239,111 @@ func (h *ScheduleHandler) Delete(c *gin.Context) {
 	}
 
 	if err := h.scheduleUC.Delete(id); err != nil {
-		utils.ErrorResponse(c, http.StatusInternalServerError, err.Error(), nil)
+		utils.ErrorResponse(c, http.StatusBadRequest, err.Error(), nil)
 		return
 	}
 	utils.SuccessResponse(c, http.StatusOK, "Schedule deleted", nil)
 }
 
+// Cancel godoc
+// @Summary      Cancel schedule
+// @Description  Cancel a schedule with a reason. Pending transactions are cancelled, paid ones are refunded or credited, and ticket holders are notified by email (Admin only)
+// @Tags         Schedules
+// @Accept       json
+// @Produce      json
+// @Param        id       path      string                          true  "Schedule UUID"
+// @Param        request  body      request.CancelScheduleRequest   true  "Cancellation Data"
+// @Success      200      {object}  utils.APIResponse{data=response.ScheduleCancellationResponse}
+// @Failure      400      {object}  utils.APIResponse
+// @Router       /schedules/{id}/cancel [post]
+// @Security     BearerAuth
+func (h *ScheduleHandler) Cancel(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, "Invalid UUID", nil)
+		return
+	}
+
+	var req request.CancelScheduleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, "Invalid body format", err.Error())
+		return
+	}
+	if err := h.val.Validate(req); err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, "Validation error", validator.FormatError(err))
+		return
+	}
+
+	if !requireBodyScope(c, h.scopeUC.CheckSchedule, req.ReplacementScheduleID) {
+		return
+	}
+
+	report, err := h.scheduleUC.Cancel(id, req)
+	if err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	utils.SuccessResponse(c, http.StatusOK, "Schedule cancelled", report)
+}
+
+// GetPriceHistory godoc
+// @Summary      Get schedule price history
+// @Description  List every base and dynamic price change of a schedule, newest first (Admin only)
+// @Tags         Schedules
+// @Accept       json
+// @Produce      json
+// @Param        id   path      string  true  "Schedule UUID"
+// @Success      200  {object}  utils.APIResponse{data=[]domain.SchedulePriceHistory}
+// @Failure      404  {object}  utils.APIResponse
+// @Router       /schedules/{id}/price-history [get]
+// @Security     BearerAuth
+func (h *ScheduleHandler) GetPriceHistory(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, "Invalid UUID", nil)
+		return
+	}
+
+	histories, err := h.scheduleUC.GetPriceHistory(id)
+	if err != nil {
+		utils.ErrorResponse(c, http.StatusNotFound, err.Error(), nil)
+		return
+	}
+	utils.SuccessResponse(c, http.StatusOK, "Schedule price history", histories)
+}
+
 func (h *ScheduleHandler) mapResponse(s *domain.Schedule) response.ScheduleResponse {
+	loc := s.Studio.Location()
 	return response.ScheduleResponse{
 		ID:        s.ID,
-		StartTime: s.StartTime,
-		EndTime:   s.EndTime,
+		StartTime: s.StartTime.In(loc),
+		EndTime:   s.EndTime.In(loc),
+		Timezone:  loc.String(),
 		Price:     s.Price,
+		MinPrice:  s.MinPrice,
+		MaxPrice:  s.MaxPrice,
+		// Atribut tayang
+		Format:           s.Format,
+		Features:         s.Features,
+		AudioLanguage:    s.AudioLanguage,
+		SubtitleLanguage: s.SubtitleLanguage,
+		// Jendela penjualan
+		SalesOpenAt:  localTimePtr(s.SalesOpenAt, loc),
+		SalesCloseAt: localTimePtr(s.SalesCloseAt, loc),
+		// Ketersediaan kursi
+		AvailableSeats: s.AvailableSeats,
+		SoldOut:        s.SoldOut,
+
+		Status:                s.Status,
+		CancellationReason:    s.CancellationReason,
+		ReplacementScheduleID: s.ReplacementScheduleID,
 		Studio: response.StudioResponse{
-			ID:       s.Studio.ID,
-			Name:     s.Studio.Name,
-			Capacity: s.Studio.Capacity,
+			ID:              s.Studio.ID,
+			Name:            s.Studio.Name,
+			Capacity:        s.Studio.Capacity,
+			CleaningMinutes: s.Studio.CleaningMinutes,
+			Timezone:        s.Studio.Timezone,
+			Features:        s.Studio.Features,
 		},
 		Movie: response.MovieResponse{
 			ID:          s.Movie.ID,
@@ -174,6 +352,16 @@ func (h *ScheduleHandler) mapResponse(s *domain.Schedule) response.ScheduleRespo
 			Duration:    s.Movie.Duration,
 			Genre:       s.Movie.Genre,
 			PosterURL:   s.Movie.PosterURL,
+			AgeRating:   s.Movie.AgeRating,
 		},
 	}
 }
+
+// localTimePtr mengonversi waktu opsional ke zona waktu studio
+func localTimePtr(t *time.Time, loc *time.Location) *time.Time {
+	if t == nil {
+		return nil
+	}
+	local := t.In(loc)
+	return &local
+}
@@ -0,0 +1,75 @@
+package handler
+
+import (
+	"errors"
+	_ "movie-app/internal/delivery/http/dto/response"
+	"movie-app/internal/delivery/http/middleware"
+	"movie-app/internal/usecase"
+	"movie-app/pkg/utils"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ScheduleImportHandler struct {
+	importUC usecase.ScheduleImportUseCase
+}
+
+func NewScheduleImportHandler(importUC usecase.ScheduleImportUseCase) *ScheduleImportHandler {
+	return &ScheduleImportHandler{importUC}
+}
+
+// Import godoc
+// @Summary      Bulk import schedules
+// @Description  Import showtimes from a CSV (header: movie,studio,start_time,end_time,price) or JSON array file. Movie and studio accept a UUID or exact title / name. Every row is validated first; if any row fails nothing is saved (Admin only)
+// @Tags         Schedules
+// @Accept       multipart/form-data
+// @Produce      json
+// @Param        file     formData  file    true   "CSV or JSON file"
+// @Param        format   formData  string  false  "csv or json (default: from file extension)"
+// @Param        dry_run  query     bool    false  "Validate only, do not save" default(false)
+// @Success      200  {object}  utils.APIResponse{data=response.ScheduleImportResponse} "Dry run"
+// @Success      201  {object}  utils.APIResponse{data=response.ScheduleImportResponse}
+// @Failure      400  {object}  utils.APIResponse
+// @Failure      422  {object}  utils.APIResponse{errors=response.ScheduleImportResponse} "Some rows are invalid"
+// @Router       /schedules/import [post]
+// @Security     BearerAuth
+func (h *ScheduleImportHandler) Import(c *gin.Context) {
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, "File is required", nil)
+		return
+	}
+
+	format := strings.ToLower(c.PostForm("format"))
+	if format == "" {
+		format = strings.TrimPrefix(strings.ToLower(filepath.Ext(fileHeader.Filename)), ".")
+	}
+	dryRun, _ := strconv.ParseBool(c.DefaultQuery("dry_run", "false"))
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, "Failed to read file", err.Error())
+		return
+	}
+	defer file.Close()
+
+	report, err := h.importUC.Import(middleware.AdminScopeFromContext(c), file, format, dryRun)
+	if errors.Is(err, usecase.ErrImportInvalid) {
+		utils.ErrorResponse(c, http.StatusUnprocessableEntity, err.Error(), report)
+		return
+	}
+	if err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	if dryRun {
+		utils.SuccessResponse(c, http.StatusOK, "All rows are valid", report)
+		return
+	}
+	utils.SuccessResponse(c, http.StatusCreated, "Schedules imported", report)
+}
@@ -0,0 +1,90 @@
+package handler
+
+import (
+	"movie-app/internal/delivery/http/dto/request"
+	_ "movie-app/internal/delivery/http/dto/response"
+	"movie-app/internal/usecase"
+	"movie-app/pkg/utils"
+	"movie-app/pkg/validator"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type SchedulePlannerHandler struct {
+	plannerUC usecase.SchedulePlannerUseCase
+	scopeUC   usecase.AdminScopeUseCase
+	val       *validator.CustomValidator
+}
+
+func NewSchedulePlannerHandler(plannerUC usecase.SchedulePlannerUseCase, scopeUC usecase.AdminScopeUseCase, val *validator.CustomValidator) *SchedulePlannerHandler {
+	return &SchedulePlannerHandler{plannerUC, scopeUC, val}
+}
+
+// Plan godoc
+// @Summary      Propose weekly schedule
+// @Description  Generate non-overlapping showtime proposals for the given studios and week. Prime-time slots go to the highest-weighted movies; existing schedules and cleaning buffers are respected. Nothing is saved (Admin only)
+// @Tags         Schedules
+// @Accept       json
+// @Produce      json
+// @Param        request body request.PlanScheduleRequest true "Planner Input"
+// @Success      200  {object}  utils.APIResponse{data=response.SchedulePlanResponse}
+// @Failure      400  {object}  utils.APIResponse
+// @Router       /schedules/planner [post]
+// @Security     BearerAuth
+func (h *SchedulePlannerHandler) Plan(c *gin.Context) {
+	var req request.PlanScheduleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, "Invalid body format", err.Error())
+		return
+	}
+	if err := h.val.Validate(req); err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, "Validation error", validator.FormatError(err))
+		return
+	}
+
+	if !requireBodyScope(c, h.scopeUC.CheckStudio, req.StudioIDs...) {
+		return
+	}
+
+	plan, err := h.plannerUC.Plan(req)
+	if err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	utils.SuccessResponse(c, http.StatusOK, "Schedule proposals", plan)
+}
+
+// Commit godoc
+// @Summary      Commit schedule proposals
+// @Description  Create the reviewed proposals through the normal schedule creation flow. Each item is validated and created independently (Admin only)
+// @Tags         Schedules
+// @Accept       json
+// @Produce      json
+// @Param        request body request.CommitSchedulePlanRequest true "Proposals to create"
+// @Success      201  {object}  utils.APIResponse{data=response.SchedulePlanCommitResponse}
+// @Failure      400  {object}  utils.APIResponse
+// @Router       /schedules/planner/commit [post]
+// @Security     BearerAuth
+func (h *SchedulePlannerHandler) Commit(c *gin.Context) {
+	var req request.CommitSchedulePlanRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, "Invalid body format", err.Error())
+		return
+	}
+	if err := h.val.Validate(req); err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, "Validation error", validator.FormatError(err))
+		return
+	}
+
+	studioIDs := make([]string, 0, len(req.Schedules))
+	for _, s := range req.Schedules {
+		studioIDs = append(studioIDs, s.StudioID)
+	}
+	if !requireBodyScope(c, h.scopeUC.CheckStudio, studioIDs...) {
+		return
+	}
+
+	result := h.plannerUC.Commit(req)
+	utils.SuccessResponse(c, http.StatusCreated, "Schedule proposals committed", result)
+}
@@ -0,0 +1,155 @@
+package handler
+
+import (
+	"errors"
+	"movie-app/internal/delivery/http/dto/request"
+	_ "movie-app/internal/delivery/http/dto/response"
+	_ "movie-app/internal/domain"
+	"movie-app/internal/usecase"
+	"movie-app/pkg/utils"
+	"movie-app/pkg/validator"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type ScheduleSeriesHandler struct {
+	seriesUC usecase.ScheduleSeriesUseCase
+	scopeUC  usecase.AdminScopeUseCase
+	val      *validator.CustomValidator
+}
+
+func NewScheduleSeriesHandler(seriesUC usecase.ScheduleSeriesUseCase, scopeUC usecase.AdminScopeUseCase, val *validator.CustomValidator) *ScheduleSeriesHandler {
+	return &ScheduleSeriesHandler{seriesUC, scopeUC, val}
+}
+
+// Create godoc
+// @Summary      Create schedule series
+// @Description  Generate showtimes for every selected weekday and start time in a date range, in one transaction (Admin only)
+// @Tags         Schedule Series
+// @Accept       json
+// @Produce      json
+// @Param        request body request.CreateScheduleSeriesRequest true "Series Data"
+// @Success      201  {object}  utils.APIResponse{data=response.ScheduleSeriesResponse}
+// @Failure      400  {object}  utils.APIResponse
+// @Failure      409  {object}  utils.APIResponse{errors=response.ScheduleSeriesResponse} "Conflict report per occurrence"
+// @Router       /schedule-series [post]
+// @Security     BearerAuth
+func (h *ScheduleSeriesHandler) Create(c *gin.Context) {
+	var req request.CreateScheduleSeriesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, "Invalid body format", err.Error())
+		return
+	}
+	if err := h.val.Validate(req); err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, "Validation error", validator.FormatError(err))
+		return
+	}
+
+	if !requireBodyScope(c, h.scopeUC.CheckStudio, req.StudioID) {
+		return
+	}
+
+	report, err := h.seriesUC.Create(req)
+	if errors.Is(err, usecase.ErrSeriesConflict) {
+		utils.ErrorResponse(c, http.StatusConflict, err.Error(), report)
+		return
+	}
+	if err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	utils.SuccessResponse(c, http.StatusCreated, "Schedule series created", report)
+}
+
+// GetByID godoc
+// @Summary      Get schedule series
+// @Description  Get a schedule series with all its occurrences (Admin only)
+// @Tags         Schedule Series
+// @Accept       json
+// @Produce      json
+// @Param        id   path      string  true  "Series UUID"
+// @Success      200  {object}  utils.APIResponse{data=domain.ScheduleSeries}
+// @Failure      404  {object}  utils.APIResponse
+// @Router       /schedule-series/{id} [get]
+// @Security     BearerAuth
+func (h *ScheduleSeriesHandler) GetByID(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, "Invalid UUID", nil)
+		return
+	}
+
+	series, err := h.seriesUC.GetByID(id)
+	if err != nil {
+		utils.ErrorResponse(c, http.StatusNotFound, err.Error(), nil)
+		return
+	}
+	utils.SuccessResponse(c, http.StatusOK, "Schedule series found", series)
+}
+
+// Update godoc
+// @Summary      Update schedule series
+// @Description  Change price and price bounds of all or only future occurrences (Admin only)
+// @Tags         Schedule Series
+// @Accept       json
+// @Produce      json
+// @Param        id       path    string  true   "Series UUID"
+// @Param        scope    query   string  false  "all or future (default)"
+// @Param        request  body    request.UpdateScheduleSeriesRequest true "Update Data"
+// @Success      200      {object} utils.APIResponse{data=response.ScheduleSeriesResponse}
+// @Failure      400      {object} utils.APIResponse
+// @Router       /schedule-series/{id} [put]
+// @Security     BearerAuth
+func (h *ScheduleSeriesHandler) Update(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, "Invalid UUID", nil)
+		return
+	}
+
+	var req request.UpdateScheduleSeriesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, "Invalid body format", err.Error())
+		return
+	}
+	if err := h.val.Validate(req); err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, "Validation error", validator.FormatError(err))
+		return
+	}
+
+	report, err := h.seriesUC.Update(id, c.Query("scope"), req)
+	if err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	utils.SuccessResponse(c, http.StatusOK, "Schedule series updated", report)
+}
+
+// Delete godoc
+// @Summary      Delete schedule series
+// @Description  Delete all or only future occurrences. Occurrences with sold tickets are skipped (Admin only)
+// @Tags         Schedule Series
+// @Accept       json
+// @Produce      json
+// @Param        id     path    string  true   "Series UUID"
+// @Param        scope  query   string  false  "all or future (default)"
+// @Success      200    {object} utils.APIResponse{data=response.ScheduleSeriesResponse}
+// @Failure      400    {object} utils.APIResponse
+// @Router       /schedule-series/{id} [delete]
+// @Security     BearerAuth
+func (h *ScheduleSeriesHandler) Delete(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, "Invalid UUID", nil)
+		return
+	}
+
+	report, err := h.seriesUC.Delete(id, c.Query("scope"))
+	if err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	utils.SuccessResponse(c, http.StatusOK, "Schedule series deleted", report)
+}
@@ -0,0 +1,42 @@
+package handler
+
+import (
+	"errors"
+	"movie-app/internal/delivery/http/middleware"
+	"movie-app/internal/domain"
+	"movie-app/internal/usecase"
+	"movie-app/pkg/utils"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// scopeErrorStatus: 403 jika admin cabang mengakses cinema lain, selain itu status bawaan handler
+func scopeErrorStatus(err error, fallback int) int {
+	if errors.Is(err, usecase.ErrOutsideAdminScope) {
+		return http.StatusForbidden
+	}
+	return fallback
+}
+
+// requireBodyScope memeriksa ID dari body request (studio_id, schedule_id, dst) terhadap scope admin.
+// ID kosong / tidak valid dilewati (sudah ditangani validator). Mengembalikan false jika response 403 sudah dikirim.
+func requireBodyScope(c *gin.Context, check func(scope domain.AdminScope, id uuid.UUID) error, ids ...string) bool {
+	scope := middleware.AdminScopeFromContext(c)
+	if scope.Global {
+		return true
+	}
+
+	for _, idStr := range ids {
+		id, err := uuid.Parse(idStr)
+		if err != nil {
+			continue
+		}
+		if err := check(scope, id); err != nil {
+			utils.ErrorResponse(c, http.StatusForbidden, err.Error(), nil)
+			return false
+		}
+	}
+	return true
+}
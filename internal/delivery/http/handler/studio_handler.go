@@ -3,6 +3,7 @@ package handler
 import (
 	"movie-app/internal/delivery/http/dto/request"
 	"movie-app/internal/delivery/http/dto/response"
+	"movie-app/internal/delivery/http/middleware"
 	"movie-app/internal/usecase"
 	"movie-app/pkg/utils"
 	"movie-app/pkg/validator"
@@ -46,13 +47,13 @@ func (h *StudioHandler) Create(c *gin.Context) {
 		return
 	}
 
-	studio, err := h.studioUC.Create(req)
+	studio, err := h.studioUC.Create(middleware.AdminScopeFromContext(c), req)
 	if err != nil {
-		utils.ErrorResponse(c, http.StatusInternalServerError, err.Error(), nil)
+		utils.ErrorResponse(c, scopeErrorStatus(err, http.StatusInternalServerError), err.Error(), nil)
 		return
 	}
 
-	res := response.StudioResponse{ID: studio.ID, Name: studio.Name, Capacity: studio.Capacity}
+	res := response.StudioResponse{ID: studio.ID, CinemaID: studio.CinemaID, Name: studio.Name, Capacity: studio.Capacity, CleaningMinutes: studio.CleaningMinutes, Timezone: studio.Timezone, Features: studio.Features}
 	utils.SuccessResponse(c, http.StatusCreated, "Studio created", res)
 }
 
@@ -72,7 +73,7 @@ func (h *StudioHandler) GetAll(c *gin.Context) {
 	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
 	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
 
-	studios, meta, err := h.studioUC.GetAll(page, limit)
+	studios, meta, err := h.studioUC.GetAll(middleware.AdminScopeFromContext(c), page, limit)
 	if err != nil {
 		utils.ErrorResponse(c, http.StatusInternalServerError, err.Error(), nil)
 		return
@@ -81,7 +82,7 @@ func (h *StudioHandler) GetAll(c *gin.Context) {
 	// Mapping response list
 	var res []response.StudioResponse
 	for _, s := range studios {
-		res = append(res, response.StudioResponse{ID: s.ID, Name: s.Name, Capacity: s.Capacity})
+		res = append(res, response.StudioResponse{ID: s.ID, CinemaID: s.CinemaID, Name: s.Name, Capacity: s.Capacity, CleaningMinutes: s.CleaningMinutes, Timezone: s.Timezone, Features: s.Features})
 	}
 
 	// Kita butuh wrapper khusus untuk list dengan pagination
@@ -119,7 +120,7 @@ func (h *StudioHandler) GetByID(c *gin.Context) {
 		return
 	}
 
-	res := response.StudioResponse{ID: studio.ID, Name: studio.Name, Capacity: studio.Capacity}
+	res := response.StudioResponse{ID: studio.ID, CinemaID: studio.CinemaID, Name: studio.Name, Capacity: studio.Capacity, CleaningMinutes: studio.CleaningMinutes, Timezone: studio.Timezone, Features: studio.Features}
 	utils.SuccessResponse(c, http.StatusOK, "Studio found", res)
 }
 
@@ -149,13 +150,13 @@ func (h *StudioHandler) Update(c *gin.Context) {
 		return
 	}
 
-	studio, err := h.studioUC.Update(id, req)
+	studio, err := h.studioUC.Update(middleware.AdminScopeFromContext(c), id, req)
 	if err != nil {
-		utils.ErrorResponse(c, http.StatusInternalServerError, err.Error(), nil)
+		utils.ErrorResponse(c, scopeErrorStatus(err, http.StatusInternalServerError), err.Error(), nil)
 		return
 	}
 
-	res := response.StudioResponse{ID: studio.ID, Name: studio.Name, Capacity: studio.Capacity}
+	res := response.StudioResponse{ID: studio.ID, CinemaID: studio.CinemaID, Name: studio.Name, Capacity: studio.Capacity, CleaningMinutes: studio.CleaningMinutes, Timezone: studio.Timezone, Features: studio.Features}
 	utils.SuccessResponse(c, http.StatusOK, "Studio updated", res)
 }
 
@@ -185,3 +186,41 @@ func (h *StudioHandler) Delete(c *gin.Context) {
 
 	utils.SuccessResponse(c, http.StatusOK, "Studio deleted", nil)
 }
+
+// UpdateSeatCategory godoc
+// @Summary      Update seat category
+// @Description  Set the category (regular, premium, couple) of several seats in a studio (Admin only)
+// @Tags         Studios
+// @Accept       json
+// @Produce      json
+// @Param        id       path    string  true  "Studio UUID"
+// @Param        request  body    request.UpdateSeatCategoryRequest true "Seats & Category"
+// @Success      200      {object} utils.APIResponse
+// @Failure      400      {object} utils.APIResponse
+// @Router       /studios/{id}/seats/category [put]
+// @Security     BearerAuth
+func (h *StudioHandler) UpdateSeatCategory(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, "Invalid UUID", nil)
+		return
+	}
+
+	var req request.UpdateSeatCategoryRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, "Invalid body", err.Error())
+		return
+	}
+
+	if err := h.val.Validate(req); err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, "Validation error", validator.FormatError(err))
+		return
+	}
+
+	if err := h.studioUC.UpdateSeatCategory(id, req); err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	utils.SuccessResponse(c, http.StatusOK, "Seat category updated", nil)
+}
@@ -4,6 +4,7 @@ import (
 	"movie-app/internal/delivery/http/dto/request"
 	_ "movie-app/internal/delivery/http/dto/response"
 	_ "movie-app/internal/domain"
+	"movie-app/internal/enums"
 	"movie-app/internal/usecase"
 	"movie-app/pkg/utils"
 	"movie-app/pkg/validator"
@@ -15,21 +16,22 @@ import (
 
 type TicketHandler struct {
 	ticketUC usecase.TicketUseCase
+	scopeUC  usecase.AdminScopeUseCase
 	val      *validator.CustomValidator
 }
 
-func NewTicketHandler(ticketUC usecase.TicketUseCase, val *validator.CustomValidator) *TicketHandler {
-	return &TicketHandler{ticketUC, val}
+func NewTicketHandler(ticketUC usecase.TicketUseCase, scopeUC usecase.AdminScopeUseCase, val *validator.CustomValidator) *TicketHandler {
+	return &TicketHandler{ticketUC, scopeUC, val}
 }
 
 // GetAvailableSeats godoc
 // @Summary      Get available seats
-// @Description  Check which seats are booked or free for a schedule
+// @Description  Check which seats are booked or free for a schedule, and whether the schedule can currently be booked (with reason)
 // @Tags         Ticketing
 // @Accept       json
 // @Produce      json
 // @Param        id   path      string  true  "Schedule UUID"
-// @Success      200  {object}  utils.APIResponse{data=[]response.SeatAvailabilityResponse}
+// @Success      200  {object}  utils.APIResponse{data=response.ScheduleSeatsResponse}
 // @Router       /tickets/schedules/{id}/seats [get]
 // @Security     BearerAuth
 func (h *TicketHandler) GetAvailableSeats(c *gin.Context) {
@@ -80,6 +82,10 @@ func (h *TicketHandler) BookTicket(c *gin.Context) {
 		return
 	}
 
+	if !h.requireBoxOfficeScope(c, req) {
+		return
+	}
+
 	// 3. Call UseCase
 	transaction, err := h.ticketUC.BookTicket(userID, req)
 	if err != nil {
@@ -91,6 +97,49 @@ func (h *TicketHandler) BookTicket(c *gin.Context) {
 	utils.SuccessResponse(c, http.StatusCreated, "Booking successful, waiting for payment", transaction)
 }
 
+// QuoteBooking godoc
+// @Summary      Get price quote
+// @Description  Read-only price breakdown for seats, promo code and payment method, using the same pricing as booking
+// @Tags         Ticketing
+// @Accept       json
+// @Produce      json
+// @Param        request body request.BookTicketRequest true "Booking Data"
+// @Success      200  {object}  utils.APIResponse{data=response.QuoteResponse}
+// @Failure      400  {object}  utils.APIResponse
+// @Router       /tickets/quote [post]
+// @Security     BearerAuth
+func (h *TicketHandler) QuoteBooking(c *gin.Context) {
+	userIDStr, exists := c.Get("user_id")
+	if !exists {
+		utils.ErrorResponse(c, http.StatusUnauthorized, "Unauthorized", nil)
+		return
+	}
+	userID, _ := uuid.Parse(userIDStr.(string))
+
+	var req request.BookTicketRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, "Invalid body", err.Error())
+		return
+	}
+
+	if err := h.val.Validate(req); err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, "Validation error", validator.FormatError(err))
+		return
+	}
+
+	if !h.requireBoxOfficeScope(c, req) {
+		return
+	}
+
+	quote, err := h.ticketUC.QuoteBooking(userID, req)
+	if err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	utils.SuccessResponse(c, http.StatusOK, "Price quote", quote)
+}
+
 // GetUserHistory godoc
 // @Summary      Get booking history
 // @Description  Get all transaction history for current user
@@ -112,3 +161,11 @@ func (h *TicketHandler) GetUserHistory(c *gin.Context) {
 
 	utils.SuccessResponse(c, http.StatusOK, "User booking history", history)
 }
+
+// requireBoxOfficeScope: penjualan box office oleh admin cabang hanya untuk jadwal di cinema-nya
+func (h *TicketHandler) requireBoxOfficeScope(c *gin.Context, req request.BookTicketRequest) bool {
+	if req.SalesChannel != enums.SalesChannelBoxOffice {
+		return true
+	}
+	return requireBodyScope(c, h.scopeUC.CheckSchedule, req.ScheduleID)
+}
@@ -0,0 +1,149 @@
+package handler
+
+import (
+	"movie-app/internal/delivery/http/dto/request"
+	_ "movie-app/internal/domain"
+	"movie-app/internal/usecase"
+	"movie-app/pkg/utils"
+	"movie-app/pkg/validator"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type TicketTypeHandler struct {
+	ticketTypeUC usecase.TicketTypeUseCase
+	val          *validator.CustomValidator
+}
+
+func NewTicketTypeHandler(ticketTypeUC usecase.TicketTypeUseCase, val *validator.CustomValidator) *TicketTypeHandler {
+	return &TicketTypeHandler{ticketTypeUC, val}
+}
+
+// Create godoc
+// @Summary      Create ticket type
+// @Description  Add a ticket type with a price modifier, e.g. child or student (Super admin only)
+// @Tags         Ticket Types
+// @Accept       json
+// @Produce      json
+// @Param        request body request.CreateTicketTypeRequest true "Ticket Type Data"
+// @Success      201  {object}  utils.APIResponse{data=domain.TicketType}
+// @Failure      400  {object}  utils.APIResponse
+// @Router       /ticket-types [post]
+// @Security     BearerAuth
+func (h *TicketTypeHandler) Create(c *gin.Context) {
+	var req request.CreateTicketTypeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, "Invalid body", err.Error())
+		return
+	}
+	if err := h.val.Validate(req); err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, "Validation error", validator.FormatError(err))
+		return
+	}
+
+	ticketType, err := h.ticketTypeUC.Create(req)
+	if err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	utils.SuccessResponse(c, http.StatusCreated, "Ticket type created", ticketType)
+}
+
+// GetActive godoc
+// @Summary      Get ticket types
+// @Description  List ticket types that can be chosen per seat when booking (Public)
+// @Tags         Ticket Types
+// @Accept       json
+// @Produce      json
+// @Success      200  {object}  utils.APIResponse{data=[]domain.TicketType}
+// @Router       /ticket-types [get]
+func (h *TicketTypeHandler) GetActive(c *gin.Context) {
+	ticketTypes, err := h.ticketTypeUC.GetActive()
+	if err != nil {
+		utils.ErrorResponse(c, http.StatusInternalServerError, err.Error(), nil)
+		return
+	}
+	utils.SuccessResponse(c, http.StatusOK, "List ticket types", ticketTypes)
+}
+
+// GetAll godoc
+// @Summary      Get all ticket types
+// @Description  List all ticket types, including inactive ones (Admin only)
+// @Tags         Ticket Types
+// @Accept       json
+// @Produce      json
+// @Success      200  {object}  utils.APIResponse{data=[]domain.TicketType}
+// @Router       /ticket-types/all [get]
+// @Security     BearerAuth
+func (h *TicketTypeHandler) GetAll(c *gin.Context) {
+	ticketTypes, err := h.ticketTypeUC.GetAll()
+	if err != nil {
+		utils.ErrorResponse(c, http.StatusInternalServerError, err.Error(), nil)
+		return
+	}
+	utils.SuccessResponse(c, http.StatusOK, "List ticket types", ticketTypes)
+}
+
+// Update godoc
+// @Summary      Update ticket type
+// @Description  Change name, price modifier, requirement or status of a ticket type (Super admin only)
+// @Tags         Ticket Types
+// @Accept       json
+// @Produce      json
+// @Param        id       path    string  true  "Ticket Type UUID"
+// @Param        request  body    request.UpdateTicketTypeRequest true "Update Data"
+// @Success      200      {object} utils.APIResponse{data=domain.TicketType}
+// @Failure      400      {object} utils.APIResponse
+// @Router       /ticket-types/{id} [put]
+// @Security     BearerAuth
+func (h *TicketTypeHandler) Update(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, "Invalid UUID", nil)
+		return
+	}
+
+	var req request.UpdateTicketTypeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, "Invalid body", err.Error())
+		return
+	}
+	if err := h.val.Validate(req); err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, "Validation error", validator.FormatError(err))
+		return
+	}
+
+	ticketType, err := h.ticketTypeUC.Update(id, req)
+	if err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	utils.SuccessResponse(c, http.StatusOK, "Ticket type updated", ticketType)
+}
+
+// Delete godoc
+// @Summary      Delete ticket type
+// @Description  Remove a ticket type. The default adult type cannot be deleted (Super admin only)
+// @Tags         Ticket Types
+// @Accept       json
+// @Produce      json
+// @Param        id   path      string  true  "Ticket Type UUID"
+// @Success      200  {object}  utils.APIResponse
+// @Failure      400  {object}  utils.APIResponse
+// @Router       /ticket-types/{id} [delete]
+// @Security     BearerAuth
+func (h *TicketTypeHandler) Delete(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, "Invalid UUID", nil)
+		return
+	}
+
+	if err := h.ticketTypeUC.Delete(id); err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	utils.SuccessResponse(c, http.StatusOK, "Ticket type deleted", nil)
+}
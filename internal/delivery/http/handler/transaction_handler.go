@@ -96,6 +96,71 @@ func (h *TransactionHandler) CancelTransaction(c *gin.Context) {
 	utils.SuccessResponse(c, http.StatusOK, "Transaction cancelled successfully", nil)
 }
 
+// RefundTransaction godoc
+// @Summary      Refund transaction
+// @Description  Refund a paid transaction, freeing its seats and promo usage (Admin only)
+// @Tags         Transactions
+// @Accept       json
+// @Produce      json
+// @Param        id   path      string  true  "Transaction UUID"
+// @Success      200  {object}  utils.APIResponse
+// @Failure      400  {object}  utils.APIResponse
+// @Router       /transactions/{id}/refund [post]
+// @Security     BearerAuth
+func (h *TransactionHandler) RefundTransaction(c *gin.Context) {
+	transactionID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, "Invalid UUID", nil)
+		return
+	}
+
+	if err := h.transUC.RefundTransaction(transactionID); err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	utils.SuccessResponse(c, http.StatusOK, "Transaction refunded successfully", nil)
+}
+
+// ExtendExpiry godoc
+// @Summary      Extend payment deadline
+// @Description  Extend the payment deadline of a pending transaction (Admin only)
+// @Tags         Transactions
+// @Accept       json
+// @Produce      json
+// @Param        id       path    string  true  "Transaction UUID"
+// @Param        request  body    request.ExtendTransactionRequest true "Extra minutes"
+// @Success      200      {object} utils.APIResponse{data=domain.Transaction}
+// @Failure      400      {object} utils.APIResponse
+// @Router       /transactions/{id}/extend [post]
+// @Security     BearerAuth
+func (h *TransactionHandler) ExtendExpiry(c *gin.Context) {
+	transactionID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, "Invalid UUID", nil)
+		return
+	}
+
+	var req request.ExtendTransactionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, "Invalid body", err.Error())
+		return
+	}
+
+	if err := h.val.Validate(req); err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, "Validation error", validator.FormatError(err))
+		return
+	}
+
+	transaction, err := h.transUC.ExtendExpiry(transactionID, req.Minutes)
+	if err != nil {
+		utils.ErrorResponse(c, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	utils.SuccessResponse(c, http.StatusOK, "Payment deadline extended", transaction)
+}
+
 func (h *TransactionHandler) GetUserTransactions(c *gin.Context) {
 	userIDStr, _ := c.Get("user_id")
 	userID, _ := uuid.Parse(userIDStr.(string))
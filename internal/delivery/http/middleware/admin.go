@@ -1,16 +1,23 @@
 package middleware
 
 import (
+	"fmt"
+	"movie-app/internal/constants"
+	"movie-app/internal/domain"
+	"movie-app/internal/enums"
 	"movie-app/pkg/utils"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 )
 
+// AdminMiddleware mengizinkan admin cabang & super admin. Pembatasan per cinema dilakukan
+// oleh RequireScope / handler memakai AdminScopeFromContext.
 func AdminMiddleware() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		role, exists := c.Get("role")
-		if !exists || role != "admin" {
+		if !exists || (role != string(enums.RoleAdmin) && role != string(enums.RoleSuperAdmin)) {
 			utils.ErrorResponse(c, http.StatusForbidden, "Access denied: admins only", nil)
 			c.Abort()
 			return
@@ -18,3 +25,86 @@ func AdminMiddleware() gin.HandlerFunc {
 		c.Next()
 	}
 }
+
+// SuperAdminMiddleware untuk endpoint global (kelola cinema & admin cabang)
+func SuperAdminMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		role, exists := c.Get("role")
+		if !exists || role != string(enums.RoleSuperAdmin) {
+			utils.ErrorResponse(c, http.StatusForbidden, "Access denied: super admins only", nil)
+			c.Abort()
+			return
+		}
+		c.Next()
+	}
+}
+
+// LoadAdminScope memuat cinema admin cabang dari database setiap request (dipasang setelah AuthMiddleware).
+// Scope sengaja tidak disimpan di JWT agar perubahan assignment langsung berlaku.
+func LoadAdminScope(loader func(userID uuid.UUID) ([]uuid.UUID, error)) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		role, _ := c.Get(constants.CtxRole)
+		if role != string(enums.RoleAdmin) {
+			c.Next()
+			return
+		}
+
+		userIDStr, _ := c.Get(constants.CtxUserID)
+		userID, err := uuid.Parse(fmt.Sprint(userIDStr))
+		if err != nil {
+			utils.ErrorResponse(c, http.StatusUnauthorized, "Invalid user ID format", nil)
+			c.Abort()
+			return
+		}
+
+		cinemaIDs, err := loader(userID)
+		if err != nil {
+			utils.ErrorResponse(c, http.StatusInternalServerError, "Failed to load admin scope", nil)
+			c.Abort()
+			return
+		}
+		c.Set(constants.CtxCinemaIDs, cinemaIDs)
+		c.Next()
+	}
+}
+
+// AdminScopeFromContext membaca scope cinema pemanggil yang dimuat LoadAdminScope.
+// Hanya role admin yang dibatasi; super admin & user biasa global.
+// Jika scope belum dimuat, admin cabang tidak mendapat akses cinema mana pun.
+func AdminScopeFromContext(c *gin.Context) domain.AdminScope {
+	role, _ := c.Get(constants.CtxRole)
+	if role != string(enums.RoleAdmin) {
+		return domain.AdminScope{Global: true}
+	}
+
+	scope := domain.AdminScope{CinemaIDs: []uuid.UUID{}}
+	raw, _ := c.Get(constants.CtxCinemaIDs)
+	if ids, ok := raw.([]uuid.UUID); ok {
+		scope.CinemaIDs = append(scope.CinemaIDs, ids...)
+	}
+	return scope
+}
+
+// RequireScope menolak (403) admin cabang yang mengakses resource di luar cinema-nya.
+// check menerima ID dari path param; UUID tidak valid / resource tidak ditemukan diteruskan ke handler.
+func RequireScope(param string, check func(scope domain.AdminScope, id uuid.UUID) error) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		scope := AdminScopeFromContext(c)
+		if scope.Global {
+			c.Next()
+			return
+		}
+
+		id, err := uuid.Parse(c.Param(param))
+		if err != nil {
+			c.Next()
+			return
+		}
+		if err := check(scope, id); err != nil {
+			utils.ErrorResponse(c, http.StatusForbidden, err.Error(), nil)
+			c.Abort()
+			return
+		}
+		c.Next()
+	}
+}
@@ -4,15 +4,15 @@ import (
 	"movie-app/internal/config"
 	"movie-app/internal/delivery/http/handler"
 	"movie-app/internal/delivery/http/middleware"
+	"movie-app/internal/usecase"
 
 	"github.com/gin-gonic/gin"
 )
 
-func SetupRoutes(r *gin.RouterGroup, authHandler *handler.AuthHandler, studioHandler *handler.StudioHandler, movieHandler *handler.MovieHandler, scheduleHandler *handler.ScheduleHandler, ticketHandler *handler.TicketHandler, transactionHandler *handler.TransactionHandler, reportHandler *handler.ReportHandler, promoHandler *handler.PromoHandler, cfg *config.Config) {
+func SetupRoutes(r *gin.RouterGroup, authHandler *handler.AuthHandler, studioHandler *handler.StudioHandler, movieHandler *handler.MovieHandler, scheduleHandler *handler.ScheduleHandler, ticketHandler *handler.TicketHandler, transactionHandler *handler.TransactionHandler, reportHandler *handler.ReportHandler, promoHandler *handler.PromoHandler, paymentMethodHandler *handler.PaymentMethodHandler, campaignHandler *handler.CampaignHandler, pricingRuleHandler *handler.PricingRuleHandler, ticketTypeHandler *handler.TicketTypeHandler, calendarHandler *handler.CalendarHandler, scheduleSeriesHandler *handler.ScheduleSeriesHandler, scheduleImportHandler *handler.ScheduleImportHandler, schedulePlannerHandler *handler.SchedulePlannerHandler, calendarFeedHandler *handler.CalendarFeedHandler, cinemaHandler *handler.CinemaHandler, adminScopeUC usecase.AdminScopeUseCase, cfg *config.Config) {
 	auth := r.Group("/auth")
 	{
 		auth.POST("/register", authHandler.Register)
-		auth.POST("/register-admin", authHandler.RegisterAdmin)
 		auth.POST("/login", authHandler.Login)
 
 		protected := auth.Group("/")
@@ -22,25 +22,64 @@ func SetupRoutes(r *gin.RouterGroup, authHandler *handler.AuthHandler, studioHan
 		}
 	}
 
+	// Admin cabang & cinema yang dikelola (Super admin)
+	admins := r.Group("/admins")
+	admins.Use(middleware.AuthMiddleware(cfg))
+	admins.Use(middleware.SuperAdminMiddleware())
+	{
+		admins.POST("", authHandler.RegisterAdmin)
+		admins.PUT("/:id/cinemas", authHandler.SetAdminCinemas)
+	}
+
+	// Admin cabang hanya boleh mengakses resource di cinema yang di-assign (super admin bebas).
+	// loadScope memuat cinema admin dari database, wajib dipasang sebelum RequireScope / AdminScopeFromContext.
+	loadScope := middleware.LoadAdminScope(adminScopeUC.CinemaIDsOf)
+
+	// Konfigurasi global (film, promo, harga, metode bayar, kalender) berlaku untuk semua cinema,
+	// jadi perubahannya hanya oleh super admin; admin cabang tetap boleh membaca.
+	superAdmin := middleware.SuperAdminMiddleware()
+	studioScope := middleware.RequireScope("id", adminScopeUC.CheckStudio)
+	scheduleScope := middleware.RequireScope("id", adminScopeUC.CheckSchedule)
+
 	// studio routes
 	studio := r.Group("/studios")
 	// all route studio membutuhkan login admin
-	studio.Use(middleware.AuthMiddleware(cfg))
+	studio.Use(middleware.AuthMiddleware(cfg), loadScope)
 	{
 		// Public (User biasa bisa lihat)
 		studio.GET("", studioHandler.GetAll)
-		studio.GET("/:id", studioHandler.GetByID)
+		studio.GET("/:id", studioScope, studioHandler.GetByID)
 
 		// Admin only (Create, Update, Delete)
 		admin := studio.Group("/")
 		admin.Use(middleware.AdminMiddleware())
 		{
 			admin.POST("", studioHandler.Create)
-			admin.PUT("/:id", studioHandler.Update)
-			admin.DELETE("/:id", studioHandler.Delete)
+			admin.PUT("/:id", studioScope, studioHandler.Update)
+			admin.DELETE("/:id", studioScope, studioHandler.Delete)
+			admin.PUT("/:id/seats/category", studioScope, studioHandler.UpdateSeatCategory)
 		}
 	}
 
+	// Cinema routes
+	cinemas := r.Group("/cinemas")
+
+	// Public (daftar cabang, cari terdekat & jadwal per cabang)
+	cinemas.GET("", cinemaHandler.GetAll)
+	cinemas.GET("/nearby", cinemaHandler.Nearby)
+	cinemas.GET("/:id", cinemaHandler.GetByID)
+	cinemas.GET("/:id/schedules", scheduleHandler.GetByCinema)
+
+	// Admin Only: admin cabang hanya boleh mengubah cinema-nya, tambah & hapus cabang oleh super admin
+	cinemasAdmin := cinemas.Group("/")
+	cinemasAdmin.Use(middleware.AuthMiddleware(cfg), loadScope)
+	cinemasAdmin.Use(middleware.AdminMiddleware())
+	{
+		cinemasAdmin.POST("", superAdmin, cinemaHandler.Create)
+		cinemasAdmin.PUT("/:id", middleware.RequireScope("id", adminScopeUC.CheckCinema), cinemaHandler.Update)
+		cinemasAdmin.DELETE("/:id", superAdmin, cinemaHandler.Delete)
+	}
+
 	// Movie Routes
 	movies := r.Group("/movies")
 
@@ -53,9 +92,9 @@ func SetupRoutes(r *gin.RouterGroup, authHandler *handler.AuthHandler, studioHan
 	moviesAdmin.Use(middleware.AuthMiddleware(cfg))
 	moviesAdmin.Use(middleware.AdminMiddleware())
 	{
-		moviesAdmin.POST("", movieHandler.Create)
-		moviesAdmin.PUT("/:id", movieHandler.Update)
-		moviesAdmin.DELETE("/:id", movieHandler.Delete)
+		moviesAdmin.POST("", superAdmin, movieHandler.Create)
+		moviesAdmin.PUT("/:id", superAdmin, movieHandler.Update)
+		moviesAdmin.DELETE("/:id", superAdmin, movieHandler.Delete)
 	}
 
 	// Schedule route
@@ -65,23 +104,53 @@ func SetupRoutes(r *gin.RouterGroup, authHandler *handler.AuthHandler, studioHan
 
 	// Admin Only
 	schedulesAdmin := schedules.Group("/")
-	schedulesAdmin.Use(middleware.AuthMiddleware(cfg))
+	schedulesAdmin.Use(middleware.AuthMiddleware(cfg), loadScope)
 	schedulesAdmin.Use(middleware.AdminMiddleware())
 	{
 		schedulesAdmin.POST("", scheduleHandler.Create)
-		schedulesAdmin.PUT("/:id", scheduleHandler.Update)
-		schedulesAdmin.DELETE("/:id", scheduleHandler.Delete)
+		schedulesAdmin.POST("/import", scheduleImportHandler.Import)
+		schedulesAdmin.POST("/planner", schedulePlannerHandler.Plan)
+		schedulesAdmin.POST("/planner/commit", schedulePlannerHandler.Commit)
+		schedulesAdmin.PUT("/:id", scheduleScope, scheduleHandler.Update)
+		schedulesAdmin.DELETE("/:id", scheduleScope, scheduleHandler.Delete)
+		schedulesAdmin.POST("/:id/cancel", scheduleScope, scheduleHandler.Cancel)
+		schedulesAdmin.GET("/:id/pricing-preview", scheduleScope, pricingRuleHandler.PreviewSchedule)
+		schedulesAdmin.GET("/:id/price-history", scheduleScope, scheduleHandler.GetPriceHistory)
+	}
+
+	// Schedule series route (Admin)
+	scheduleSeries := r.Group("/schedule-series")
+	scheduleSeries.Use(middleware.AuthMiddleware(cfg), loadScope)
+	scheduleSeries.Use(middleware.AdminMiddleware())
+	{
+		scheduleSeries.POST("", scheduleSeriesHandler.Create)
+		seriesScope := middleware.RequireScope("id", adminScopeUC.CheckSeries)
+		scheduleSeries.GET("/:id", seriesScope, scheduleSeriesHandler.GetByID)
+		scheduleSeries.PUT("/:id", seriesScope, scheduleSeriesHandler.Update)
+		scheduleSeries.DELETE("/:id", seriesScope, scheduleSeriesHandler.Delete)
 	}
 
 	// ticket & booking route
 	tickets := r.Group("/tickets")
-	tickets.Use(middleware.AuthMiddleware(cfg)) // User harus login
+	tickets.Use(middleware.AuthMiddleware(cfg), loadScope) // User harus login
 	{
 		tickets.GET("/schedules/:id/seats", ticketHandler.GetAvailableSeats)
 
+		tickets.POST("/quote", ticketHandler.QuoteBooking)
 		tickets.POST("/book", ticketHandler.BookTicket)
 
 		tickets.GET("/me", ticketHandler.GetUserHistory)
+		tickets.GET("/me/calendar", calendarFeedHandler.GetTicketFeedURL)
+		tickets.POST("/me/calendar/rotate", calendarFeedHandler.RotateTicketFeedURL)
+	}
+
+	// Calendar feed (.ics) untuk di-subscribe aplikasi kalender (tanpa JWT)
+	feeds := r.Group("/feeds")
+	{
+		feeds.GET("/movies/:id", calendarFeedHandler.MovieFeed)
+		feeds.GET("/studios/:id", calendarFeedHandler.StudioFeed)
+		feeds.GET("/cinemas/:id", calendarFeedHandler.CinemaFeed)
+		feeds.GET("/tickets/:token", calendarFeedHandler.TicketFeed) // Token rahasia per user
 	}
 
 	// Transaction & payment route
@@ -91,16 +160,28 @@ func SetupRoutes(r *gin.RouterGroup, authHandler *handler.AuthHandler, studioHan
 		transactions.GET("/me", transactionHandler.GetUserTransactions)
 		transactions.POST("/:id/pay", transactionHandler.PayTransaction)
 		transactions.POST("/:id/cancel", transactionHandler.CancelTransaction)
+		transactions.GET("/:id/payment-methods", paymentMethodHandler.GetAvailableForTransaction)
+
+		// Admin only: perpanjang batas waktu pembayaran & refund
+		transactionsAdmin := transactions.Group("/")
+		transactionsAdmin.Use(loadScope, middleware.AdminMiddleware())
+		{
+			trxScope := middleware.RequireScope("id", adminScopeUC.CheckTransaction)
+			transactionsAdmin.POST("/:id/extend", trxScope, transactionHandler.ExtendExpiry)
+			transactionsAdmin.POST("/:id/refund", trxScope, transactionHandler.RefundTransaction)
+		}
 	}
 
 	// Report route (admin only)
 	reports := r.Group("/reports")
-	reports.Use(middleware.AuthMiddleware(cfg))
+	reports.Use(middleware.AuthMiddleware(cfg), loadScope)
 	reports.Use(middleware.AdminMiddleware())
 	{
 		reports.GET("/revenue", reportHandler.GetRevenueReport)
 		reports.GET("/revenue/export", reportHandler.ExportRevenueCSV)
 		reports.GET("/top-movies", reportHandler.GetTopMovies)
+		reports.GET("/ticket-types", reportHandler.GetTicketTypeSales)
+		reports.GET("/revenue/day-types", reportHandler.GetDayTypeRevenue)
 	}
 
 	// Promo route (Admin)
@@ -108,9 +189,75 @@ func SetupRoutes(r *gin.RouterGroup, authHandler *handler.AuthHandler, studioHan
 	promos.Use(middleware.AuthMiddleware(cfg))
 	promos.Use(middleware.AdminMiddleware())
 	{
-		promos.POST("", promoHandler.Create)
+		promos.POST("", superAdmin, promoHandler.Create)
 		promos.GET("", promoHandler.GetAll)
-		promos.PUT("/:id", promoHandler.Update)
-		promos.DELETE("/:id", promoHandler.Delete)
+		promos.PUT("/:id", superAdmin, promoHandler.Update)
+		promos.DELETE("/:id", superAdmin, promoHandler.Delete)
+		promos.GET("/:id/redemptions", superAdmin, promoHandler.GetRedemptions)
+	}
+
+	// Payment method catalog (Admin)
+	paymentMethods := r.Group("/payment-methods")
+	paymentMethods.Use(middleware.AuthMiddleware(cfg))
+	paymentMethods.Use(middleware.AdminMiddleware())
+	{
+		paymentMethods.POST("", superAdmin, paymentMethodHandler.Create)
+		paymentMethods.GET("", paymentMethodHandler.GetAll)
+		paymentMethods.PUT("/:id", superAdmin, paymentMethodHandler.Update)
+		paymentMethods.DELETE("/:id", superAdmin, paymentMethodHandler.Delete)
+	}
+
+	// Voucher campaign route (Admin)
+	campaigns := r.Group("/campaigns")
+	campaigns.Use(middleware.AuthMiddleware(cfg))
+	campaigns.Use(middleware.AdminMiddleware())
+	{
+		campaigns.POST("", superAdmin, campaignHandler.Create)
+		campaigns.GET("", campaignHandler.GetAll)
+		campaigns.GET("/:id", campaignHandler.GetByID)
+		campaigns.POST("/:id/codes", superAdmin, campaignHandler.GenerateCodes)
+		campaigns.GET("/:id/codes", superAdmin, campaignHandler.GetCodes)
+		campaigns.GET("/:id/codes/export", superAdmin, campaignHandler.ExportCodesCSV)
+		campaigns.POST("/:id/codes/revoke", superAdmin, campaignHandler.RevokeCodes)
+	}
+
+	// Pricing rule route (Admin)
+	pricingRules := r.Group("/pricing-rules")
+	pricingRules.Use(middleware.AuthMiddleware(cfg))
+	pricingRules.Use(middleware.AdminMiddleware())
+	{
+		pricingRules.POST("", superAdmin, pricingRuleHandler.Create)
+		pricingRules.GET("", pricingRuleHandler.GetAll)
+		pricingRules.PUT("/:id", superAdmin, pricingRuleHandler.Update)
+		pricingRules.DELETE("/:id", superAdmin, pricingRuleHandler.Delete)
+	}
+
+	// Ticket type route
+	ticketTypes := r.Group("/ticket-types")
+
+	// Public (dipakai saat memilih tipe tiket per kursi)
+	ticketTypes.GET("", ticketTypeHandler.GetActive)
+
+	// Admin Only
+	ticketTypesAdmin := ticketTypes.Group("/")
+	ticketTypesAdmin.Use(middleware.AuthMiddleware(cfg))
+	ticketTypesAdmin.Use(middleware.AdminMiddleware())
+	{
+		ticketTypesAdmin.GET("/all", ticketTypeHandler.GetAll)
+		ticketTypesAdmin.POST("", superAdmin, ticketTypeHandler.Create)
+		ticketTypesAdmin.PUT("/:id", superAdmin, ticketTypeHandler.Update)
+		ticketTypesAdmin.DELETE("/:id", superAdmin, ticketTypeHandler.Delete)
+	}
+
+	// Holiday & special date calendar (Admin)
+	calendar := r.Group("/calendar")
+	calendar.Use(middleware.AuthMiddleware(cfg))
+	calendar.Use(middleware.AdminMiddleware())
+	{
+		calendar.POST("", superAdmin, calendarHandler.Create)
+		calendar.GET("", calendarHandler.GetAll)
+		calendar.PUT("/:id", superAdmin, calendarHandler.Update)
+		calendar.DELETE("/:id", superAdmin, calendarHandler.Delete)
+		calendar.POST("/import", superAdmin, calendarHandler.ImportICal)
 	}
 }
@@ -9,14 +9,16 @@ import (
 )
 
 type Scheduler struct {
-	transUC usecase.TransactionUseCase
-	ticker  *time.Ticker
-	quit    chan bool
+	transUC   usecase.TransactionUseCase
+	pricingUC usecase.PricingRuleUseCase
+	ticker    *time.Ticker
+	quit      chan bool
 }
 
-func NewScheduler(transUC usecase.TransactionUseCase) *Scheduler {
+func NewScheduler(transUC usecase.TransactionUseCase, pricingUC usecase.PricingRuleUseCase) *Scheduler {
 	return &Scheduler{
-		transUC: transUC,
+		transUC:   transUC,
+		pricingUC: pricingUC,
 		// Ticker default 1 menit, bisa diambil dari config sebenarnya
 		ticker: time.NewTicker(1 * time.Minute),
 		quit:   make(chan bool),
@@ -54,4 +56,9 @@ func (s *Scheduler) runJobs() {
 	if err := s.transUC.SendUpcomingScheduleReminders(); err != nil {
 		logger.Log.Error("Scheduler: Reminder error", zap.Error(err))
 	}
+
+	// Job 3: Riwayat harga dinamis (harga berubah karena waktu / okupansi)
+	if err := s.pricingUC.RecordUpcomingPrices(time.Now()); err != nil {
+		logger.Log.Error("Scheduler: Price history error", zap.Error(err))
+	}
 }
@@ -0,0 +1,38 @@
+package domain
+
+import "github.com/google/uuid"
+
+// AdminScope adalah cinema yang boleh diakses pemanggil.
+// Global untuk super admin & user biasa (endpoint baca publik); admin cabang dibatasi ke CinemaIDs.
+type AdminScope struct {
+	Global    bool
+	CinemaIDs []uuid.UUID
+}
+
+// AllowsCinema mengecek akses ke cinema. Studio tanpa cinema (nil) hanya bisa diakses scope global.
+func (s AdminScope) AllowsCinema(cinemaID *uuid.UUID) bool {
+	if s.Global {
+		return true
+	}
+	if cinemaID == nil {
+		return false
+	}
+	for _, id := range s.CinemaIDs {
+		if id == *cinemaID {
+			return true
+		}
+	}
+	return false
+}
+
+// CinemaFilter mengembalikan filter cinema untuk query repository: nil = tanpa filter,
+// slice kosong (bukan nil) = admin tanpa cinema, tidak ada data yang boleh terlihat.
+func (s AdminScope) CinemaFilter() []uuid.UUID {
+	if s.Global {
+		return nil
+	}
+	if s.CinemaIDs == nil {
+		return []uuid.UUID{}
+	}
+	return s.CinemaIDs
+}
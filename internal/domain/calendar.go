@@ -0,0 +1,15 @@
+package domain
+
+import "time"
+
+// CalendarDate menandai tanggal libur / event khusus untuk pricing, promo & laporan
+type CalendarDate struct {
+	BaseModel
+	Name        string    `gorm:"type:varchar(150);not null" json:"name"`
+	Date        time.Time `gorm:"type:date;not null" json:"date"`
+	DayType     string    `gorm:"type:varchar(20);not null;default:'holiday'" json:"day_type"` // holiday, special_event
+	IsRecurring bool      `gorm:"default:false" json:"is_recurring"`                           // Berulang tiap tahun
+	Description string    `gorm:"type:text" json:"description"`
+	Source      string    `gorm:"type:varchar(20);not null;default:'manual'" json:"source"` // manual, ical
+	ExternalUID string    `gorm:"type:varchar(255)" json:"external_uid,omitempty"`
+}
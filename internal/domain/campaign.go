@@ -0,0 +1,33 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Campaign mengelompokkan voucher sekali pakai yang berbagi satu definisi promo
+type Campaign struct {
+	BaseModel
+	Name        string    `gorm:"type:varchar(150);not null" json:"name"`
+	Partner     string    `gorm:"type:varchar(150)" json:"partner"`
+	Description string    `gorm:"type:text" json:"description"`
+	PromoID     uuid.UUID `gorm:"type:uuid;not null" json:"promo_id"`
+
+	// Relations
+	Promo Promo `gorm:"foreignKey:PromoID" json:"promo,omitempty"`
+}
+
+type VoucherCode struct {
+	BaseModel
+	CampaignID    uuid.UUID  `gorm:"type:uuid;not null;index" json:"campaign_id"`
+	Code          string     `gorm:"type:varchar(50);uniqueIndex;not null" json:"code"`
+	RedeemedBy    *uuid.UUID `gorm:"type:uuid" json:"redeemed_by"`
+	RedeemedAt    *time.Time `json:"redeemed_at"`
+	TransactionID *uuid.UUID `gorm:"type:uuid" json:"transaction_id"`
+	RevokedAt     *time.Time `json:"revoked_at"`
+
+	// Relations
+	Campaign Campaign `gorm:"foreignKey:CampaignID" json:"-"`
+	User     *User    `gorm:"foreignKey:RedeemedBy" json:"user,omitempty"`
+}
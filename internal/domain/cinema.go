@@ -0,0 +1,38 @@
+package domain
+
+import (
+	"movie-app/pkg/utils"
+	"time"
+)
+
+// Cinema adalah cabang bioskop yang membawahi beberapa studio
+type Cinema struct {
+	BaseModel
+	Name      string  `gorm:"type:varchar(150);not null" json:"name"`
+	Address   string  `gorm:"type:text;not null" json:"address"`
+	City      string  `gorm:"type:varchar(100);not null" json:"city"`
+	Latitude  float64 `gorm:"not null" json:"latitude"`
+	Longitude float64 `gorm:"not null" json:"longitude"`
+	// Zona waktu IANA, dipakai sebagai default zona waktu studio baru di cinema ini
+	Timezone string `gorm:"type:varchar(64);not null;default:'Asia/Jakarta'" json:"timezone"`
+
+	// Jam operasional (HH:MM jam lokal, closing boleh lewat tengah malam)
+	OpeningTime string `gorm:"type:varchar(5);not null;default:'10:00'" json:"opening_time"`
+	ClosingTime string `gorm:"type:varchar(5);not null;default:'00:00'" json:"closing_time"`
+
+	// Kontak
+	Phone string `gorm:"type:varchar(30)" json:"phone"`
+	Email string `gorm:"type:varchar(100)" json:"email"`
+
+	IsActive bool `gorm:"default:true" json:"is_active"`
+
+	// Jarak dari titik pencarian (km), hanya terisi di pencarian nearby
+	DistanceKm float64 `gorm:"->;-:migration" json:"distance_km,omitempty"`
+
+	Studios []Studio `gorm:"foreignKey:CinemaID" json:"studios,omitempty"`
+}
+
+// Location mengembalikan zona waktu cinema (UTC jika belum diatur / tidak valid)
+func (c *Cinema) Location() *time.Location {
+	return utils.LoadLocation(c.Timezone)
+}
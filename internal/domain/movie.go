@@ -1,5 +1,11 @@
 package domain
 
+import (
+	"time"
+
+	"github.com/lib/pq"
+)
+
 type Movie struct {
 	BaseModel
 	Title       string `gorm:"type:varchar(255);not null" json:"title"`
@@ -7,4 +13,15 @@ type Movie struct {
 	Duration    int    `gorm:"not null" json:"duration"`
 	Genre       string `gorm:"type:varchar(100)" json:"genre"`
 	PosterURL   string `gorm:"type:varchar(255)" json:"poster_url"`
+
+	// --- Metadata ---
+	AgeRating         string         `gorm:"type:varchar(10)" json:"age_rating"` // Klasifikasi LSF: SU, 13+, 17+, 21+
+	ReleaseDate       *time.Time     `gorm:"type:date" json:"release_date"`      // Mulai tayang
+	EndOfRunDate      *time.Time     `gorm:"type:date" json:"end_of_run_date"`   // Terakhir tayang (nil = belum ditentukan)
+	Director          string         `gorm:"type:varchar(255)" json:"director"`
+	Cast              pq.StringArray `gorm:"column:cast_members;type:text[]" json:"cast"`
+	OriginalLanguage  string         `gorm:"type:varchar(35)" json:"original_language"` // Tag bahasa BCP 47, mis. en
+	SubtitleLanguages pq.StringArray `gorm:"type:text[]" json:"subtitle_languages"`
+	TrailerURL        string         `gorm:"type:varchar(255)" json:"trailer_url"`
+	BackdropURL       string         `gorm:"type:varchar(255)" json:"backdrop_url"`
 }
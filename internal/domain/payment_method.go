@@ -0,0 +1,17 @@
+package domain
+
+type PaymentMethod struct {
+	BaseModel
+	Code          string  `gorm:"type:varchar(50);uniqueIndex;not null" json:"code"`
+	DisplayName   string  `gorm:"type:varchar(100);not null" json:"display_name"`
+	IconURL       string  `gorm:"type:varchar(255)" json:"icon_url"`
+	IsEnabled     bool    `gorm:"default:true" json:"is_enabled"`
+	MinAmount     float64 `gorm:"type:decimal(10,2);default:0" json:"min_amount"`
+	MaxAmount     float64 `gorm:"type:decimal(10,2);default:0" json:"max_amount"`   // 0 = tanpa batas
+	FeeType       string  `gorm:"type:varchar(20);default:'fixed'" json:"fee_type"` // 'percentage' or 'fixed'
+	FeeValue      float64 `gorm:"type:decimal(10,2);default:0" json:"fee_value"`
+	ExpiryMinutes int     `gorm:"default:0" json:"expiry_minutes"` // 0 = pakai PAYMENT_EXPIRY_MINUTES, override per channel tetap berlaku
+
+	// Kosong = tersedia di semua cinema
+	Cinemas []Cinema `gorm:"many2many:payment_method_cinemas;" json:"cinemas,omitempty"`
+}
@@ -0,0 +1,34 @@
+package domain
+
+import "github.com/lib/pq"
+
+// PricingRule menyesuaikan harga per kursi dari harga dasar jadwal (schedule.Price)
+type PricingRule struct {
+	BaseModel
+	Name        string `gorm:"type:varchar(100);not null" json:"name"`
+	Description string `gorm:"type:text" json:"description"`
+	Priority    int    `gorm:"not null;default:0" json:"priority"` // Lebih besar = dievaluasi lebih dulu
+	IsActive    bool   `gorm:"default:true" json:"is_active"`
+
+	// --- Kondisi (kosong = tidak dibatasi) ---
+	Weekdays       pq.StringArray `gorm:"type:text[]" json:"weekdays"`       // monday, tuesday, ...
+	StartTime      string         `gorm:"type:varchar(5)" json:"start_time"` // HH:MM
+	EndTime        string         `gorm:"type:varchar(5)" json:"end_time"`   // HH:MM
+	MovieIDs       pq.StringArray `gorm:"type:text[]" json:"movie_ids"`
+	SeatCategories pq.StringArray `gorm:"type:text[]" json:"seat_categories"`
+	DayTypes       pq.StringArray `gorm:"type:text[]" json:"day_types"` // holiday, special_event, regular (dari kalender)
+
+	// --- Kondisi atribut tayang ---
+	Formats        pq.StringArray `gorm:"type:text[]" json:"formats"`         // 2d, 3d
+	Features       pq.StringArray `gorm:"type:text[]" json:"features"`        // Cocok jika jadwal punya salah satu fitur (imax, 4dx, ...)
+	AudioLanguages pq.StringArray `gorm:"type:text[]" json:"audio_languages"` // Bahasa audio jadwal
+
+	// --- Kondisi demand (0 = tidak dibatasi) ---
+	MinOccupancy float64 `gorm:"type:decimal(5,2);default:0" json:"min_occupancy"` // Cocok jika okupansi (%) >= nilai ini
+	MaxOccupancy float64 `gorm:"type:decimal(5,2);default:0" json:"max_occupancy"` // Cocok jika okupansi (%) < nilai ini
+	WithinHours  int     `gorm:"default:0" json:"within_hours"`                    // Cocok jika jadwal mulai dalam N jam
+
+	AdjustmentType  string  `gorm:"type:varchar(20);not null;default:'fixed'" json:"adjustment_type"` // 'percentage' or 'fixed'
+	AdjustmentValue float64 `gorm:"type:decimal(10,2);not null;default:0" json:"adjustment_value"`    // Negatif = potongan
+	StopProcessing  bool    `gorm:"default:false" json:"stop_processing"`
+}
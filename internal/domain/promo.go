@@ -2,6 +2,9 @@ package domain
 
 import (
 	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
 )
 
 type Promo struct {
@@ -9,5 +12,37 @@ type Promo struct {
 	Code          string    `gorm:"type:varchar(50);uniqueIndex;not null" json:"code"`
 	DiscountType  string    `gorm:"type:varchar(20);not null" json:"discount_type"` // 'percentage' or 'fixed'
 	DiscountValue float64   `gorm:"type:decimal(10,2);not null" json:"discount_value"`
+	ValidFrom     time.Time `gorm:"not null" json:"valid_from"`
 	ValidUntil    time.Time `gorm:"not null" json:"valid_until"`
+
+	// --- Batasan Pemakaian (0 = tanpa batas) ---
+	UsageLimit           int     `gorm:"default:0" json:"usage_limit"`
+	PerUserLimit         int     `gorm:"default:0" json:"per_user_limit"`
+	MinTransactionAmount float64 `gorm:"type:decimal(10,2);default:0" json:"min_transaction_amount"`
+	MaxDiscountAmount    float64 `gorm:"type:decimal(10,2);default:0" json:"max_discount_amount"` // Cap untuk tipe percentage
+
+	// --- Eligibility / Targeting (kosong = tidak dibatasi) ---
+	MovieIDs       pq.StringArray `gorm:"type:text[]" json:"movie_ids"`
+	Genres         pq.StringArray `gorm:"type:text[]" json:"genres"`
+	StudioIDs      pq.StringArray `gorm:"type:text[]" json:"studio_ids"`
+	Weekdays       pq.StringArray `gorm:"type:text[]" json:"weekdays"`       // monday, tuesday, ...
+	StartTime      string         `gorm:"type:varchar(5)" json:"start_time"` // HH:MM
+	EndTime        string         `gorm:"type:varchar(5)" json:"end_time"`   // HH:MM
+	SeatCategories pq.StringArray `gorm:"type:text[]" json:"seat_categories"`
+	DayTypes       pq.StringArray `gorm:"type:text[]" json:"day_types"` // holiday, special_event, regular
+	FirstTimeOnly  bool           `gorm:"default:false" json:"first_time_only"`
+}
+
+// PromoRedemption mencatat setiap pemakaian promo per transaksi
+type PromoRedemption struct {
+	BaseModel
+	PromoID        uuid.UUID  `gorm:"type:uuid;not null" json:"promo_id"`
+	UserID         uuid.UUID  `gorm:"type:uuid;not null" json:"user_id"`
+	TransactionID  uuid.UUID  `gorm:"type:uuid;not null;uniqueIndex" json:"transaction_id"`
+	DiscountAmount float64    `gorm:"type:decimal(10,2);not null" json:"discount_amount"`
+	Status         string     `gorm:"type:varchar(20);not null;default:'reserved'" json:"status"` // reserved, consumed, released
+	ReleasedAt     *time.Time `json:"released_at"`
+
+	// Relations
+	User User `gorm:"foreignKey:UserID" json:"user,omitempty"`
 }
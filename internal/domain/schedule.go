@@ -4,17 +4,56 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 )
 
 type Schedule struct {
 	BaseModel
-	StudioID  uuid.UUID `gorm:"type:uuid;not null" json:"studio_id"`
-	MovieID   uuid.UUID `gorm:"type:uuid;not null" json:"movie_id"`
-	StartTime time.Time `gorm:"not null" json:"start_time"`
-	EndTime   time.Time `gorm:"not null" json:"end_time"`
-	Price     float64   `gorm:"type:decimal(10,2);not null" json:"price"`
+	StudioID  uuid.UUID  `gorm:"type:uuid;not null" json:"studio_id"`
+	MovieID   uuid.UUID  `gorm:"type:uuid;not null" json:"movie_id"`
+	StartTime time.Time  `gorm:"not null" json:"start_time"`
+	EndTime   time.Time  `gorm:"not null" json:"end_time"`
+	Price     float64    `gorm:"type:decimal(10,2);not null" json:"price"`      // Harga dasar sebelum pricing rules
+	MinPrice  float64    `gorm:"type:decimal(10,2);default:0" json:"min_price"` // Batas bawah harga per kursi (0 = tanpa batas)
+	MaxPrice  float64    `gorm:"type:decimal(10,2);default:0" json:"max_price"` // Batas atas harga per kursi (0 = tanpa batas)
+	SeriesID  *uuid.UUID `gorm:"type:uuid" json:"series_id"`                    // Terisi jika dibuat lewat schedule series
+
+	// LegacyPricing: jadwal dari sebelum pricing rules, Price sudah harga final (rules tidak diterapkan).
+	// Dilepas saat admin mengisi ulang harga lewat Update.
+	LegacyPricing bool `gorm:"not null;default:false" json:"legacy_pricing"`
+
+	// --- Atribut Tayang ---
+	Format           string         `gorm:"type:varchar(10);not null;default:'2d'" json:"format"` // 2d, 3d
+	Features         pq.StringArray `gorm:"type:text[]" json:"features"`                          // imax, 4dx, dolby_atmos, screenx (harus didukung studio)
+	AudioLanguage    string         `gorm:"type:varchar(35)" json:"audio_language,omitempty"`     // Tag bahasa BCP 47, mis. en, id
+	SubtitleLanguage string         `gorm:"type:varchar(35)" json:"subtitle_language,omitempty"`  // Kosong = tanpa subtitle
+
+	// --- Jendela Penjualan (nil = default dari config) ---
+	SalesOpenAt  *time.Time `json:"sales_open_at,omitempty"`
+	SalesCloseAt *time.Time `json:"sales_close_at,omitempty"`
+
+	// --- Pembatalan ---
+	Status                string     `gorm:"type:varchar(20);default:'active'" json:"status"` // active, cancelled
+	CancellationReason    string     `gorm:"type:text" json:"cancellation_reason,omitempty"`
+	CancelledAt           *time.Time `json:"cancelled_at,omitempty"`
+	ReplacementScheduleID *uuid.UUID `gorm:"type:uuid" json:"replacement_schedule_id,omitempty"` // Jadwal pengganti yang ditawarkan ke pemegang tiket
+
+	// Ketersediaan kursi, diisi usecase (bukan kolom tabel)
+	AvailableSeats int  `gorm:"-" json:"available_seats"`
+	SoldOut        bool `gorm:"-" json:"sold_out"`
 
 	// Relations (Preload)
 	Studio Studio `gorm:"foreignKey:StudioID" json:"studio,omitempty"`
 	Movie  Movie  `gorm:"foreignKey:MovieID" json:"movie,omitempty"`
 }
+
+// SchedulePriceHistory mencatat setiap perubahan harga jadwal
+type SchedulePriceHistory struct {
+	BaseModel
+	ScheduleID   uuid.UUID `gorm:"type:uuid;not null" json:"schedule_id"`
+	SeatCategory string    `gorm:"type:varchar(20)" json:"seat_category"` // Kosong = harga dasar jadwal
+	OldPrice     float64   `gorm:"type:decimal(10,2);default:0" json:"old_price"`
+	NewPrice     float64   `gorm:"type:decimal(10,2);not null" json:"new_price"`
+	Reason       string    `gorm:"type:varchar(20);not null" json:"reason"` // created, manual, dynamic
+	Occupancy    float64   `gorm:"type:decimal(5,2);default:0" json:"occupancy"`
+}
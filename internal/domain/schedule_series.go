@@ -0,0 +1,24 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// ScheduleSeries adalah template jadwal berulang. Setiap occurrence disimpan sebagai Schedule biasa.
+type ScheduleSeries struct {
+	BaseModel
+	StudioID   uuid.UUID      `gorm:"type:uuid;not null" json:"studio_id"`
+	MovieID    uuid.UUID      `gorm:"type:uuid;not null" json:"movie_id"`
+	StartDate  time.Time      `gorm:"type:date;not null" json:"start_date"`
+	EndDate    time.Time      `gorm:"type:date;not null" json:"end_date"`
+	Weekdays   pq.StringArray `gorm:"type:text[]" json:"weekdays"`             // Kosong = setiap hari
+	StartTimes pq.StringArray `gorm:"type:text[];not null" json:"start_times"` // HH:MM
+	Price      float64        `gorm:"type:decimal(10,2);not null" json:"price"`
+	MinPrice   float64        `gorm:"type:decimal(10,2);default:0" json:"min_price"`
+	MaxPrice   float64        `gorm:"type:decimal(10,2);default:0" json:"max_price"`
+
+	Schedules []Schedule `gorm:"foreignKey:SeriesID" json:"schedules,omitempty"`
+}
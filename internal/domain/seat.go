@@ -7,6 +7,7 @@ type Seat struct {
 	StudioID   uuid.UUID `gorm:"type:uuid;not null" json:"studio_id"`
 	RowCode    string    `gorm:"type:varchar(5);not null" json:"row_code"` // A, B, C
 	SeatNumber int       `gorm:"type:int;not null" json:"seat_number"`     // 1, 2, 3
+	Category   string    `gorm:"type:varchar(20);not null;default:'regular'" json:"category"`
 
 	// Relations
 	Studio Studio `gorm:"foreignKey:StudioID" json:"-"`
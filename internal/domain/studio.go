@@ -1,8 +1,41 @@
 package domain
 
+import (
+	"movie-app/pkg/utils"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
 type Studio struct {
 	BaseModel
-	Name     string `gorm:"type:varchar(100);not null" json:"name"`
-	Capacity int    `gorm:"not null" json:"capacity"`
-	Seats    []Seat `gorm:"foreignKey:StudioID" json:"seats,omitempty"`
+	CinemaID *uuid.UUID `gorm:"type:uuid" json:"cinema_id"` // Cabang bioskop (nil = belum di-assign)
+	Name     string     `gorm:"type:varchar(100);not null" json:"name"`
+	Capacity int        `gorm:"not null" json:"capacity"`
+	// Jeda setelah jadwal selesai sebelum jadwal berikutnya boleh mulai
+	CleaningMinutes int `gorm:"not null;default:15" json:"cleaning_minutes"`
+	// Zona waktu IANA (mis. Asia/Jakarta). Prime time, kalender & laporan harian memakai jam lokal ini.
+	Timezone string `gorm:"type:varchar(64);not null;default:'Asia/Jakarta'" json:"timezone"`
+	// Kapabilitas studio: 3d, imax, 4dx, dolby_atmos, screenx
+	Features pq.StringArray `gorm:"type:text[]" json:"features"`
+	Seats    []Seat         `gorm:"foreignKey:StudioID" json:"seats,omitempty"`
+
+	Cinema *Cinema `gorm:"foreignKey:CinemaID" json:"cinema,omitempty"`
+}
+
+// Location mengembalikan zona waktu studio (UTC jika belum diatur / tidak valid)
+func (s *Studio) Location() *time.Location {
+	return utils.LoadLocation(s.Timezone)
+}
+
+// Supports mengecek apakah studio punya kapabilitas tsb (mis. 3d, imax)
+func (s *Studio) Supports(feature string) bool {
+	for _, f := range s.Features {
+		if strings.EqualFold(f, feature) {
+			return true
+		}
+	}
+	return false
 }
@@ -1,14 +1,26 @@
 package domain
 
-import "github.com/google/uuid"
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
 
 type Ticket struct {
 	BaseModel
 	TransactionID uuid.UUID `gorm:"type:uuid;not null" json:"transaction_id"`
 	ScheduleID    uuid.UUID `gorm:"type:uuid;not null" json:"schedule_id"`
 	SeatID        uuid.UUID `gorm:"type:uuid;not null" json:"seat_id"`
+	Price         float64   `gorm:"type:decimal(10,2);not null;default:0" json:"price"` // Harga terkunci saat booking
+
+	TicketTypeID   *uuid.UUID `gorm:"type:uuid" json:"ticket_type_id"`
+	TicketTypeCode string     `gorm:"type:varchar(30);not null;default:'adult'" json:"ticket_type_code"` // Snapshot saat booking
+
+	// ReleasedAt terisi saat transaksi dibatalkan / di-refund; kursinya boleh dibooking ulang
+	ReleasedAt *time.Time `json:"released_at,omitempty"`
 
 	// Relations
-	Seat     Seat     `gorm:"foreignKey:SeatID" json:"seat,omitempty"`
-	Schedule Schedule `gorm:"foreignKey:ScheduleID" json:"-"`
+	Seat       Seat        `gorm:"foreignKey:SeatID" json:"seat,omitempty"`
+	Schedule   Schedule    `gorm:"foreignKey:ScheduleID" json:"-"`
+	TicketType *TicketType `gorm:"foreignKey:TicketTypeID" json:"ticket_type,omitempty"`
 }
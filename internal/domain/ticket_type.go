@@ -0,0 +1,14 @@
+package domain
+
+// TicketType mengubah harga kursi sesuai jenis penonton (adult, child, student, senior, ...)
+type TicketType struct {
+	BaseModel
+	Code            string  `gorm:"type:varchar(30);uniqueIndex;not null" json:"code"`
+	Name            string  `gorm:"type:varchar(100);not null" json:"name"`
+	Description     string  `gorm:"type:text" json:"description"`
+	AdjustmentType  string  `gorm:"type:varchar(20);not null;default:'percentage'" json:"adjustment_type"` // 'percentage' or 'fixed'
+	AdjustmentValue float64 `gorm:"type:decimal(10,2);not null;default:0" json:"adjustment_value"`         // Negatif = potongan
+	Requirement     string  `gorm:"type:varchar(255)" json:"requirement"`                                  // Dicek saat check-in
+	IsActive        bool    `gorm:"default:true" json:"is_active"`
+	SortOrder       int     `gorm:"default:0" json:"sort_order"`
+}
@@ -14,12 +14,15 @@ type Transaction struct {
 	TotalAmount   float64                 `gorm:"type:decimal(10,2);not null" json:"total_amount"`
 	Status        enums.TransactionStatus `gorm:"type:varchar(20);default:'pending'" json:"status"`
 	PaymentMethod string                  `gorm:"type:varchar(50)" json:"payment_method"`
-	ExpiresAt     time.Time               `gorm:"-" json:"expires_at"`
+	PaymentFee    float64                 `gorm:"type:decimal(10,2);default:0" json:"payment_fee"`
+	SalesChannel  string                  `gorm:"type:varchar(20);default:'web'" json:"sales_channel"`
+	ExpiresAt     time.Time               `gorm:"not null" json:"expires_at"` // Batas waktu pembayaran
 
 	// --- Tambahan Field Promo ---
-	PromoID        *uuid.UUID `gorm:"type:uuid" json:"promo_id"` // Pointer karena bisa null
+	PromoID        *uuid.UUID `gorm:"type:uuid" json:"promo_id"`        // Pointer karena bisa null
+	VoucherCodeID  *uuid.UUID `gorm:"type:uuid" json:"voucher_code_id"` // Terisi jika promo dipakai lewat voucher campaign
 	DiscountAmount float64    `gorm:"type:decimal(10,2);default:0" json:"discount_amount"`
-	FinalAmount    float64    `gorm:"type:decimal(10,2);not null" json:"final_amount"` // Total setelah diskon
+	FinalAmount    float64    `gorm:"type:decimal(10,2);not null" json:"final_amount"` // Total setelah diskon + biaya pembayaran
 
 	// Relations
 	User    User     `gorm:"foreignKey:UserID" json:"user,omitempty"`
@@ -8,4 +8,10 @@ type User struct {
 	Email    string     `gorm:"type:varchar(100);uniqueIndex;not null" json:"email"`
 	Password string     `gorm:"type:varchar(255);not null" json:"-"`
 	Role     enums.Role `gorm:"type:varchar(20);default:'user'" json:"role"` // Menggunakan Enum
+
+	CreditBalance float64 `gorm:"type:decimal(10,2);default:0" json:"credit_balance"` // Saldo kredit (kompensasi pembatalan)
+	// Cinema yang dikelola (hanya untuk role admin)
+	Cinemas []Cinema `gorm:"many2many:admin_cinemas;joinForeignKey:UserID;joinReferences:CinemaID" json:"cinemas,omitempty"`
+
+	CalendarToken *string `gorm:"type:varchar(64);uniqueIndex" json:"-"` // Token feed .ics tiket user (rahasia)
 }
@@ -0,0 +1,12 @@
+package domain
+
+import "github.com/google/uuid"
+
+// UserCredit adalah riwayat mutasi saldo kredit user (users.credit_balance)
+type UserCredit struct {
+	BaseModel
+	UserID        uuid.UUID  `gorm:"type:uuid;not null" json:"user_id"`
+	TransactionID *uuid.UUID `gorm:"type:uuid" json:"transaction_id"`
+	Amount        float64    `gorm:"type:decimal(10,2);not null" json:"amount"` // Positif = masuk, negatif = dipakai
+	Reason        string     `gorm:"type:text" json:"reason"`
+}
@@ -4,8 +4,9 @@ package enums
 type Role string
 
 const (
-	RoleAdmin Role = "admin"
-	RoleUser  Role = "user"
+	RoleSuperAdmin Role = "super_admin" // Akses global ke semua cinema
+	RoleAdmin      Role = "admin"       // Admin cabang, dibatasi ke cinema yang di-assign
+	RoleUser       Role = "user"
 )
 
 // --- Transaction Status Enums ---
@@ -16,13 +17,20 @@ const (
 	TransactionPaid    TransactionStatus = "paid"
 	TransactionCancel  TransactionStatus = "cancelled"
 	TransactionFailed  TransactionStatus = "failed"
+	TransactionRefund  TransactionStatus = "refunded"
 )
 
-// --- Payment Methods ---
+// --- Payment Fee Types ---
 const (
-	PaymentCreditCard = "credit_card"
-	PaymentEWallet    = "e_wallet"
-	PaymentQRIS       = "qris"
+	FeeTypePercentage = "percentage" // Misal: 2% dari total
+	FeeTypeFixed      = "fixed"      // Misal: Rp 2.500 per transaksi
+)
+
+// --- Sales Channels ---
+const (
+	SalesChannelWeb       = "web"
+	SalesChannelMobile    = "mobile"
+	SalesChannelBoxOffice = "box_office"
 )
 
 // === Discount Types ===
@@ -30,3 +38,120 @@ const (
 	DiscountTypePercentage = "percentage" // Misal: 10%
 	DiscountTypeFixed      = "fixed"      // Misal: Potongan Rp 10.000
 )
+
+// === Seat Categories ===
+const (
+	SeatCategoryRegular = "regular"
+	SeatCategoryPremium = "premium"
+	SeatCategoryCouple  = "couple"
+)
+
+// === Voucher Code Status ===
+const (
+	VoucherStatusUnused   = "unused"
+	VoucherStatusRedeemed = "redeemed"
+	VoucherStatusRevoked  = "revoked"
+)
+
+// === Promo Redemption Status ===
+const (
+	RedemptionReserved = "reserved" // Booking dibuat, belum dibayar
+	RedemptionConsumed = "consumed" // Transaksi sudah dibayar
+	RedemptionReleased = "released" // Dibatalkan / expired / refund, kuota kembali
+)
+
+// === Pricing Rule Adjustment Types ===
+const (
+	AdjustmentTypePercentage = "percentage" // Misal: +20% dari harga berjalan
+	AdjustmentTypeFixed      = "fixed"      // Misal: +Rp 10.000 per kursi (boleh negatif)
+)
+
+// === Schedule Price Change Reasons ===
+const (
+	PriceChangeCreated = "created" // Harga dasar saat jadwal dibuat
+	PriceChangeManual  = "manual"  // Harga dasar diubah admin
+	PriceChangeDynamic = "dynamic" // Harga efektif berubah karena pricing rules (okupansi, waktu, dll)
+)
+
+// === Ticket Types ===
+// Tipe lain (child, student, senior, ...) dikelola admin di tabel ticket_types
+const TicketTypeDefault = "adult" // Dipakai jika kursi dibooking tanpa memilih tipe tiket
+
+// === Calendar Day Types ===
+const (
+	DayTypeHoliday      = "holiday"       // Libur nasional / cuti bersama
+	DayTypeSpecialEvent = "special_event" // Event khusus (premiere, festival, dll)
+	DayTypeRegular      = "regular"       // Tanggal tanpa entri kalender
+)
+
+// === Calendar Date Sources ===
+const (
+	CalendarSourceManual = "manual"
+	CalendarSourceICal   = "ical"
+)
+
+// === Schedule Series Occurrence Status ===
+const (
+	OccurrenceCreated  = "created"
+	OccurrenceConflict = "conflict" // Bentrok dengan jadwal lain
+	OccurrenceUpdated  = "updated"
+	OccurrenceDeleted  = "deleted"
+	OccurrenceSkipped  = "skipped" // Tidak diubah, mis. sudah ada tiket terjual
+)
+
+// === Schedule Series Edit Scope ===
+const (
+	SeriesScopeAll    = "all"
+	SeriesScopeFuture = "future" // Hanya occurrence yang belum mulai
+)
+
+// === Schedule Status ===
+const (
+	ScheduleActive    = "active"
+	ScheduleCancelled = "cancelled"
+)
+
+// === Movie Age Rating (klasifikasi LSF) ===
+const (
+	AgeRatingSU = "SU" // Semua umur
+	AgeRating13 = "13+"
+	AgeRating17 = "17+"
+	AgeRating21 = "21+"
+)
+
+// === Screening Format (per jadwal) ===
+const (
+	Format2D = "2d"
+	Format3D = "3d" // Hanya di studio dengan fitur 3d
+)
+
+// === Studio Features (kapabilitas studio, juga dipakai sebagai fitur tayang per jadwal) ===
+const (
+	Feature3D         = "3d"
+	FeatureIMAX       = "imax"
+	Feature4DX        = "4dx"
+	FeatureDolbyAtmos = "dolby_atmos"
+	FeatureScreenX    = "screenx"
+)
+
+// === Schedule Not Sellable Reasons (endpoint kursi) ===
+const (
+	SalesNotOpen   = "not_on_sale_yet" // Belum masuk sales_open_at
+	SalesClosed    = "sales_closed"    // Sudah lewat batas booking
+	SalesCancelled = "cancelled"
+	SalesSoldOut   = "sold_out"
+)
+
+// === Schedule Cancellation Resolution (untuk transaksi yang sudah dibayar) ===
+const (
+	CancellationRefund = "refund" // Dana dikembalikan ke metode pembayaran
+	CancellationCredit = "credit" // Dana masuk ke saldo kredit user
+)
+
+// === Schedule Import Row Status ===
+const (
+	ImportRowValid   = "valid"   // Lolos validasi (dry-run / menunggu commit)
+	ImportRowInvalid = "invalid" // Ada error, lihat daftar errors
+	ImportRowCreated = "created"
+	ImportRowSkipped = "skipped" // Valid tapi tidak disimpan karena baris lain gagal (all-or-nothing)
+)
@@ -0,0 +1,77 @@
+package repository
+
+import (
+	"movie-app/internal/domain"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type CalendarRepository interface {
+	Create(date *domain.CalendarDate) error
+	Update(date *domain.CalendarDate) error
+	Delete(id uuid.UUID) error
+	FindByID(id uuid.UUID) (*domain.CalendarDate, error)
+	// FindAll: year 0 = semua tahun. Tanggal berulang selalu ikut.
+	FindAll(year int) ([]domain.CalendarDate, error)
+	// FindForDate mengembalikan entri one-off di tanggal tsb + entri berulang di bulan & hari yang sama
+	FindForDate(date time.Time) ([]domain.CalendarDate, error)
+	FindByExternalUID(uid string, date time.Time) (*domain.CalendarDate, error)
+}
+
+type calendarRepository struct {
+	db *gorm.DB
+}
+
+func NewCalendarRepository(db *gorm.DB) CalendarRepository {
+	return &calendarRepository{db}
+}
+
+func (r *calendarRepository) Create(date *domain.CalendarDate) error {
+	return r.db.Create(date).Error
+}
+
+func (r *calendarRepository) Update(date *domain.CalendarDate) error {
+	return r.db.Save(date).Error
+}
+
+func (r *calendarRepository) Delete(id uuid.UUID) error {
+	return r.db.Delete(&domain.CalendarDate{}, id).Error
+}
+
+func (r *calendarRepository) FindByID(id uuid.UUID) (*domain.CalendarDate, error) {
+	var date domain.CalendarDate
+	err := r.db.First(&date, "id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &date, nil
+}
+
+func (r *calendarRepository) FindAll(year int) ([]domain.CalendarDate, error) {
+	var dates []domain.CalendarDate
+	query := r.db.Model(&domain.CalendarDate{})
+	if year > 0 {
+		query = query.Where("is_recurring = ? OR EXTRACT(YEAR FROM date) = ?", true, year)
+	}
+	err := query.Order("EXTRACT(MONTH FROM date), EXTRACT(DAY FROM date), name").Find(&dates).Error
+	return dates, err
+}
+
+func (r *calendarRepository) FindForDate(date time.Time) ([]domain.CalendarDate, error) {
+	var dates []domain.CalendarDate
+	err := r.db.Where("(is_recurring = ? AND date = ?) OR (is_recurring = ? AND EXTRACT(MONTH FROM date) = ? AND EXTRACT(DAY FROM date) = ?)",
+		false, date.Format("2006-01-02"), true, int(date.Month()), date.Day()).
+		Find(&dates).Error
+	return dates, err
+}
+
+func (r *calendarRepository) FindByExternalUID(uid string, date time.Time) (*domain.CalendarDate, error) {
+	var dates []domain.CalendarDate
+	err := r.db.Where("external_uid = ? AND date = ?", uid, date.Format("2006-01-02")).Limit(1).Find(&dates).Error
+	if err != nil || len(dates) == 0 {
+		return nil, err
+	}
+	return &dates[0], nil
+}
@@ -0,0 +1,144 @@
+package repository
+
+import (
+	"errors"
+	"movie-app/internal/delivery/http/dto/response"
+	"movie-app/internal/domain"
+	"movie-app/internal/enums"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// ErrVoucherAlreadyUsed dikembalikan jika voucher keburu dipakai / dicabut saat booking paralel
+var ErrVoucherAlreadyUsed = errors.New("voucher code has already been used or revoked")
+
+type CampaignRepository interface {
+	Create(campaign *domain.Campaign) error
+	FindByID(id uuid.UUID) (*domain.Campaign, error)
+	FindAll() ([]domain.Campaign, error)
+	ExistsByPromoID(promoID uuid.UUID) (bool, error)
+
+	CreateCodes(codes []domain.VoucherCode) error
+	// FindExistingCodes mengembalikan kode-kode dari daftar yang sudah terpakai di DB
+	FindExistingCodes(codes []string) ([]string, error)
+	FindCodes(campaignID uuid.UUID, status string, page int, limit int) ([]domain.VoucherCode, int64, error)
+	FindAllCodes(campaignID uuid.UUID) ([]domain.VoucherCode, error)
+	GetCodeSummary(campaignID uuid.UUID) (*response.CampaignSummaryResponse, error)
+	// RevokeCodes hanya mencabut kode yang belum dipakai. codes kosong = semua kode unused.
+	RevokeCodes(campaignID uuid.UUID, codes []string) (int64, error)
+	// FindRedeemableCode mencari voucher yang belum dipakai & belum dicabut
+	FindRedeemableCode(code string) (*domain.VoucherCode, error)
+}
+
+type campaignRepository struct {
+	db *gorm.DB
+}
+
+func NewCampaignRepository(db *gorm.DB) CampaignRepository {
+	return &campaignRepository{db}
+}
+
+func (r *campaignRepository) Create(campaign *domain.Campaign) error {
+	return r.db.Omit("Promo").Create(campaign).Error
+}
+
+func (r *campaignRepository) FindByID(id uuid.UUID) (*domain.Campaign, error) {
+	var campaign domain.Campaign
+	err := r.db.Preload("Promo").First(&campaign, "id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &campaign, nil
+}
+
+func (r *campaignRepository) FindAll() ([]domain.Campaign, error) {
+	var campaigns []domain.Campaign
+	err := r.db.Preload("Promo").Order("created_at DESC").Find(&campaigns).Error
+	return campaigns, err
+}
+
+func (r *campaignRepository) ExistsByPromoID(promoID uuid.UUID) (bool, error) {
+	var count int64
+	err := r.db.Model(&domain.Campaign{}).Where("promo_id = ?", promoID).Count(&count).Error
+	return count > 0, err
+}
+
+func (r *campaignRepository) CreateCodes(codes []domain.VoucherCode) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		return tx.CreateInBatches(codes, 500).Error
+	})
+}
+
+func (r *campaignRepository) FindExistingCodes(codes []string) ([]string, error) {
+	var existing []string
+	err := r.db.Model(&domain.VoucherCode{}).Unscoped().Where("code IN ?", codes).Pluck("code", &existing).Error
+	return existing, err
+}
+
+func (r *campaignRepository) FindCodes(campaignID uuid.UUID, status string, page int, limit int) ([]domain.VoucherCode, int64, error) {
+	var codes []domain.VoucherCode
+	var total int64
+
+	query := filterVoucherStatus(r.db.Model(&domain.VoucherCode{}).Where("campaign_id = ?", campaignID), status)
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	offset := (page - 1) * limit
+	err := query.Preload("User").Order("code").Limit(limit).Offset(offset).Find(&codes).Error
+	return codes, total, err
+}
+
+func (r *campaignRepository) FindAllCodes(campaignID uuid.UUID) ([]domain.VoucherCode, error) {
+	var codes []domain.VoucherCode
+	err := r.db.Preload("User").Where("campaign_id = ?", campaignID).Order("code").Find(&codes).Error
+	return codes, err
+}
+
+func (r *campaignRepository) GetCodeSummary(campaignID uuid.UUID) (*response.CampaignSummaryResponse, error) {
+	var summary response.CampaignSummaryResponse
+	err := r.db.Model(&domain.VoucherCode{}).
+		Select(`COUNT(*) as total,
+			COUNT(*) FILTER (WHERE redeemed_at IS NULL AND revoked_at IS NULL) as unused,
+			COUNT(*) FILTER (WHERE redeemed_at IS NOT NULL) as redeemed,
+			COUNT(*) FILTER (WHERE revoked_at IS NOT NULL) as revoked`).
+		Where("campaign_id = ?", campaignID).
+		Scan(&summary).Error
+	return &summary, err
+}
+
+func (r *campaignRepository) RevokeCodes(campaignID uuid.UUID, codes []string) (int64, error) {
+	query := r.db.Model(&domain.VoucherCode{}).
+		Where("campaign_id = ? AND redeemed_at IS NULL AND revoked_at IS NULL", campaignID)
+	if len(codes) > 0 {
+		query = query.Where("code IN ?", codes)
+	}
+	result := query.Update("revoked_at", time.Now())
+	return result.RowsAffected, result.Error
+}
+
+func (r *campaignRepository) FindRedeemableCode(code string) (*domain.VoucherCode, error) {
+	var voucher domain.VoucherCode
+	err := r.db.Preload("Campaign.Promo").
+		Where("code = ? AND redeemed_at IS NULL AND revoked_at IS NULL", code).
+		First(&voucher).Error
+	if err != nil {
+		return nil, err
+	}
+	return &voucher, nil
+}
+
+func filterVoucherStatus(query *gorm.DB, status string) *gorm.DB {
+	switch status {
+	case enums.VoucherStatusUnused:
+		return query.Where("redeemed_at IS NULL AND revoked_at IS NULL")
+	case enums.VoucherStatusRedeemed:
+		return query.Where("redeemed_at IS NOT NULL")
+	case enums.VoucherStatusRevoked:
+		return query.Where("revoked_at IS NOT NULL")
+	}
+	return query
+}
@@ -0,0 +1,101 @@
+package repository
+
+import (
+	"movie-app/internal/domain"
+	"strings"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// haversineKm menghitung jarak great-circle (km) dari titik (lat, lng) ke koordinat cinema di database.
+// LEAST mencegah acos error karena pembulatan floating point saat jaraknya ~0.
+const haversineKm = `(6371 * acos(LEAST(1.0,
+	cos(radians(?)) * cos(radians(latitude)) * cos(radians(longitude) - radians(?)) +
+	sin(radians(?)) * sin(radians(latitude)))))`
+
+type CinemaRepository interface {
+	Create(cinema *domain.Cinema) error
+	Update(cinema *domain.Cinema) error
+	Delete(id uuid.UUID) error
+	FindByID(id uuid.UUID) (*domain.Cinema, error)
+	// FindAll mengembalikan cinema urut nama. city kosong = semua kota, activeOnly untuk listing publik.
+	FindAll(city string, activeOnly bool, page int, limit int) ([]domain.Cinema, int64, error)
+	// FindNearby mencari cinema aktif dalam radius (km) dari titik, urut dari yang terdekat (DistanceKm terisi)
+	FindNearby(lat, lng, radiusKm float64, limit int) ([]domain.Cinema, error)
+	CountStudios(id uuid.UUID) (int64, error)
+}
+
+type cinemaRepository struct {
+	db *gorm.DB
+}
+
+func NewCinemaRepository(db *gorm.DB) CinemaRepository {
+	return &cinemaRepository{db}
+}
+
+func (r *cinemaRepository) Create(cinema *domain.Cinema) error {
+	return r.db.Create(cinema).Error
+}
+
+func (r *cinemaRepository) Update(cinema *domain.Cinema) error {
+	return r.db.Omit("Studios").Save(cinema).Error
+}
+
+func (r *cinemaRepository) Delete(id uuid.UUID) error {
+	return r.db.Delete(&domain.Cinema{}, id).Error
+}
+
+func (r *cinemaRepository) FindByID(id uuid.UUID) (*domain.Cinema, error) {
+	var cinema domain.Cinema
+	err := r.db.Preload("Studios", func(db *gorm.DB) *gorm.DB {
+		return db.Order("name ASC")
+	}).First(&cinema, "id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &cinema, nil
+}
+
+func (r *cinemaRepository) FindAll(city string, activeOnly bool, page int, limit int) ([]domain.Cinema, int64, error) {
+	var cinemas []domain.Cinema
+	var total int64
+
+	query := r.db.Model(&domain.Cinema{})
+	if city = strings.TrimSpace(city); city != "" {
+		query = query.Where("LOWER(city) = ?", strings.ToLower(city))
+	}
+	if activeOnly {
+		query = query.Where("is_active = ?", true)
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	offset := (page - 1) * limit
+	err := query.Order("name ASC").Limit(limit).Offset(offset).Find(&cinemas).Error
+	return cinemas, total, err
+}
+
+func (r *cinemaRepository) FindNearby(lat, lng, radiusKm float64, limit int) ([]domain.Cinema, error) {
+	var cinemas []domain.Cinema
+
+	// Jarak dihitung di subquery agar bisa difilter & diurutkan lewat alias
+	withDistance := r.db.Model(&domain.Cinema{}).
+		Select("cinemas.*, "+haversineKm+" AS distance_km", lat, lng, lat).
+		Where("is_active = ?", true)
+
+	err := r.db.Table("(?) AS cinemas", withDistance).
+		Where("distance_km <= ?", radiusKm).
+		Order("distance_km ASC").
+		Limit(limit).
+		Find(&cinemas).Error
+	return cinemas, err
+}
+
+func (r *cinemaRepository) CountStudios(id uuid.UUID) (int64, error) {
+	var count int64
+	err := r.db.Model(&domain.Studio{}).Where("cinema_id = ?", id).Count(&count).Error
+	return count, err
+}
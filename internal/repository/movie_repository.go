@@ -3,18 +3,30 @@ package repository
 import (
 	"movie-app/internal/domain"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 )
 
+// MovieFilter dipakai FindAll. Field zero value berarti tidak difilter.
+type MovieFilter struct {
+	Search           string    // Judul atau genre
+	AgeRatings       []string  // SU, 13+, 17+, 21+
+	Language         string    // Bahasa asli (tidak case-sensitive)
+	SubtitleLanguage string    // Salah satu bahasa subtitle
+	ReleasedFrom     time.Time // release_date >= ReleasedFrom
+	ReleasedTo       time.Time // release_date <= ReleasedTo
+}
+
 type MovieRepository interface {
 	Create(movie *domain.Movie) error
 	Update(movie *domain.Movie) error
 	Delete(id uuid.UUID) error
 	FindByID(id uuid.UUID) (*domain.Movie, error)
-	// Update signature: Tambah parameter 'search'
-	FindAll(page int, limit int, search string) ([]domain.Movie, int64, error)
+	// FindByTitle mencari film dengan judul persis (tidak case-sensitive)
+	FindByTitle(title string) (*domain.Movie, error)
+	FindAll(filter MovieFilter, page int, limit int) ([]domain.Movie, int64, error)
 }
 
 type movieRepository struct {
@@ -48,7 +60,7 @@ func (r *movieRepository) FindByID(id uuid.UUID) (*domain.Movie, error) {
 }
 
 // === BAGIAN YANG DIUPDATE ===
-func (r *movieRepository) FindAll(page int, limit int, search string) ([]domain.Movie, int64, error) {
+func (r *movieRepository) FindAll(filter MovieFilter, page int, limit int) ([]domain.Movie, int64, error) {
 	var movies []domain.Movie
 	var total int64
 
@@ -56,12 +68,29 @@ func (r *movieRepository) FindAll(page int, limit int, search string) ([]domain.
 	query := r.db.Model(&domain.Movie{})
 
 	// 2. Logic Search (Title OR Genre)
-	if search != "" {
-		searchLower := "%" + strings.ToLower(search) + "%"
+	if filter.Search != "" {
+		searchLower := "%" + strings.ToLower(filter.Search) + "%"
 		// ILIKE untuk case-insensitive di Postgres
 		query = query.Where("LOWER(title) LIKE ? OR LOWER(genre) LIKE ?", searchLower, searchLower)
 	}
 
+	// Filter metadata: klasifikasi usia, bahasa & rentang tanggal rilis
+	if len(filter.AgeRatings) > 0 {
+		query = query.Where("age_rating IN ?", filter.AgeRatings)
+	}
+	if filter.Language != "" {
+		query = query.Where("LOWER(original_language) = LOWER(?)", filter.Language)
+	}
+	if filter.SubtitleLanguage != "" {
+		query = query.Where("EXISTS (SELECT 1 FROM unnest(subtitle_languages) AS lang WHERE LOWER(lang) = LOWER(?))", filter.SubtitleLanguage)
+	}
+	if !filter.ReleasedFrom.IsZero() {
+		query = query.Where("release_date >= ?", filter.ReleasedFrom.Format("2006-01-02"))
+	}
+	if !filter.ReleasedTo.IsZero() {
+		query = query.Where("release_date <= ?", filter.ReleasedTo.Format("2006-01-02"))
+	}
+
 	// 3. Hitung Total Data (setelah difilter)
 	if err := query.Count(&total).Error; err != nil {
 		return nil, 0, err
@@ -76,3 +105,12 @@ func (r *movieRepository) FindAll(page int, limit int, search string) ([]domain.
 
 	return movies, total, nil
 }
+
+func (r *movieRepository) FindByTitle(title string) (*domain.Movie, error) {
+	var movie domain.Movie
+	err := r.db.First(&movie, "LOWER(title) = ?", strings.ToLower(strings.TrimSpace(title))).Error
+	if err != nil {
+		return nil, err
+	}
+	return &movie, nil
+}
@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"movie-app/internal/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type PaymentMethodRepository interface {
+	Create(method *domain.PaymentMethod) error
+	Update(method *domain.PaymentMethod) error
+	Delete(id uuid.UUID) error
+	FindByID(id uuid.UUID) (*domain.PaymentMethod, error)
+	FindByCode(code string) (*domain.PaymentMethod, error)
+	FindAll() ([]domain.PaymentMethod, error)
+	FindEnabled() ([]domain.PaymentMethod, error)
+	// ReplaceCinemas mengganti daftar cinema tempat metode ini tersedia
+	ReplaceCinemas(method *domain.PaymentMethod, cinemaIDs []uuid.UUID) error
+}
+
+type paymentMethodRepository struct {
+	db *gorm.DB
+}
+
+func NewPaymentMethodRepository(db *gorm.DB) PaymentMethodRepository {
+	return &paymentMethodRepository{db}
+}
+
+func (r *paymentMethodRepository) Create(method *domain.PaymentMethod) error {
+	return r.db.Create(method).Error
+}
+
+func (r *paymentMethodRepository) Update(method *domain.PaymentMethod) error {
+	// Omit relasi agar Save tidak menyentuh tabel join (diurus ReplaceCinemas)
+	return r.db.Omit("Cinemas").Save(method).Error
+}
+
+func (r *paymentMethodRepository) Delete(id uuid.UUID) error {
+	return r.db.Delete(&domain.PaymentMethod{}, id).Error
+}
+
+func (r *paymentMethodRepository) FindByID(id uuid.UUID) (*domain.PaymentMethod, error) {
+	var method domain.PaymentMethod
+	err := r.db.Preload("Cinemas").First(&method, "id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &method, nil
+}
+
+func (r *paymentMethodRepository) FindByCode(code string) (*domain.PaymentMethod, error) {
+	var method domain.PaymentMethod
+	err := r.db.Preload("Cinemas").Where("code = ?", code).First(&method).Error
+	if err != nil {
+		return nil, err
+	}
+	return &method, nil
+}
+
+func (r *paymentMethodRepository) FindAll() ([]domain.PaymentMethod, error) {
+	var methods []domain.PaymentMethod
+	err := r.db.Preload("Cinemas").Order("display_name").Find(&methods).Error
+	return methods, err
+}
+
+func (r *paymentMethodRepository) FindEnabled() ([]domain.PaymentMethod, error) {
+	var methods []domain.PaymentMethod
+	err := r.db.Preload("Cinemas").Where("is_enabled = ?", true).Order("display_name").Find(&methods).Error
+	return methods, err
+}
+
+func (r *paymentMethodRepository) ReplaceCinemas(method *domain.PaymentMethod, cinemaIDs []uuid.UUID) error {
+	cinemas := make([]domain.Cinema, 0, len(cinemaIDs))
+	for _, id := range cinemaIDs {
+		cinemas = append(cinemas, domain.Cinema{BaseModel: domain.BaseModel{ID: id}})
+	}
+	// Omit("Cinemas.*") agar GORM hanya mengisi tabel join, tidak upsert ke tabel cinemas
+	return r.db.Model(method).Omit("Cinemas.*").Association("Cinemas").Replace(cinemas)
+}
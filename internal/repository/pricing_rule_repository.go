@@ -0,0 +1,59 @@
+package repository
+
+import (
+	"movie-app/internal/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type PricingRuleRepository interface {
+	Create(rule *domain.PricingRule) error
+	Update(rule *domain.PricingRule) error
+	Delete(id uuid.UUID) error
+	FindByID(id uuid.UUID) (*domain.PricingRule, error)
+	FindAll() ([]domain.PricingRule, error)
+	// FindActive mengembalikan rule aktif, urut sesuai urutan evaluasi
+	FindActive() ([]domain.PricingRule, error)
+}
+
+type pricingRuleRepository struct {
+	db *gorm.DB
+}
+
+func NewPricingRuleRepository(db *gorm.DB) PricingRuleRepository {
+	return &pricingRuleRepository{db}
+}
+
+func (r *pricingRuleRepository) Create(rule *domain.PricingRule) error {
+	return r.db.Create(rule).Error
+}
+
+func (r *pricingRuleRepository) Update(rule *domain.PricingRule) error {
+	return r.db.Save(rule).Error
+}
+
+func (r *pricingRuleRepository) Delete(id uuid.UUID) error {
+	return r.db.Delete(&domain.PricingRule{}, id).Error
+}
+
+func (r *pricingRuleRepository) FindByID(id uuid.UUID) (*domain.PricingRule, error) {
+	var rule domain.PricingRule
+	err := r.db.First(&rule, "id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &rule, nil
+}
+
+func (r *pricingRuleRepository) FindAll() ([]domain.PricingRule, error) {
+	var rules []domain.PricingRule
+	err := r.db.Order("priority DESC, created_at ASC").Find(&rules).Error
+	return rules, err
+}
+
+func (r *pricingRuleRepository) FindActive() ([]domain.PricingRule, error) {
+	var rules []domain.PricingRule
+	err := r.db.Where("is_active = ?", true).Order("priority DESC, created_at ASC").Find(&rules).Error
+	return rules, err
+}
@@ -1,13 +1,21 @@
 package repository
 
 import (
+	"errors"
 	"movie-app/internal/domain"
+	"movie-app/internal/enums"
 
 	"github.com/google/uuid"
 
 	"gorm.io/gorm"
 )
 
+// Error sentinel untuk pengecekan kuota promo yang dilakukan di dalam db transaction
+var (
+	ErrPromoUsageLimitReached = errors.New("promo usage limit reached")
+	ErrPromoUserLimitReached  = errors.New("you have reached the usage limit for this promo")
+)
+
 type PromoRepository interface {
 	Create(promo *domain.Promo) error
 	FindByID(id uuid.UUID) (*domain.Promo, error)
@@ -15,6 +23,9 @@ type PromoRepository interface {
 	Delete(id uuid.UUID) error
 	FindByCode(code string) (*domain.Promo, error)
 	FindAll() ([]domain.Promo, error)
+	// FindRedemptions: status kosong = semua status
+	FindRedemptions(promoID uuid.UUID, status string) ([]domain.PromoRedemption, error)
+	CountRedemptionsByStatus(promoID uuid.UUID) (map[string]int64, error)
 }
 
 type promoRepository struct {
@@ -31,8 +42,11 @@ func (r *promoRepository) Create(promo *domain.Promo) error {
 
 func (r *promoRepository) FindByCode(code string) (*domain.Promo, error) {
 	var promo domain.Promo
-	// Cek kode dan pastikan belum expired
-	err := r.db.Where("code = ? AND valid_until > NOW()", code).First(&promo).Error
+	// Cek kode dan pastikan sedang dalam periode berlaku.
+	// Promo milik campaign hanya bisa dipakai lewat voucher code, bukan kode promonya langsung.
+	err := r.db.Where("code = ? AND valid_from <= NOW() AND valid_until > NOW()", code).
+		Where("NOT EXISTS (SELECT 1 FROM campaigns WHERE campaigns.promo_id = promos.id AND campaigns.deleted_at IS NULL)").
+		First(&promo).Error
 	return &promo, err
 }
 
@@ -55,3 +69,38 @@ func (r *promoRepository) Update(promo *domain.Promo) error {
 func (r *promoRepository) Delete(id uuid.UUID) error {
 	return r.db.Delete(&domain.Promo{}, id).Error
 }
+
+func (r *promoRepository) FindRedemptions(promoID uuid.UUID, status string) ([]domain.PromoRedemption, error) {
+	var redemptions []domain.PromoRedemption
+	query := r.db.Preload("User").Where("promo_id = ?", promoID)
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+	err := query.Order("created_at DESC").Find(&redemptions).Error
+	return redemptions, err
+}
+
+func (r *promoRepository) CountRedemptionsByStatus(promoID uuid.UUID) (map[string]int64, error) {
+	var rows []struct {
+		Status string
+		Total  int64
+	}
+	err := r.db.Model(&domain.PromoRedemption{}).
+		Select("status, COUNT(*) as total").
+		Where("promo_id = ?", promoID).
+		Group("status").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	counts := map[string]int64{
+		enums.RedemptionReserved: 0,
+		enums.RedemptionConsumed: 0,
+		enums.RedemptionReleased: 0,
+	}
+	for _, row := range rows {
+		counts[row.Status] = row.Total
+	}
+	return counts, nil
+}
@@ -5,12 +5,16 @@ import (
 	"movie-app/internal/delivery/http/dto/response"
 	"movie-app/internal/enums"
 
+	"github.com/google/uuid"
 	"gorm.io/gorm"
 )
 
+// ReportRepository: cinemaIDs nil = semua cinema, selain itu hanya penjualan di studio cinema tsb
 type ReportRepository interface {
-	GetTopMovies(limit int) ([]response.TopMovieResponse, error)
-	GetRevenueReport(groupBy string) ([]response.DailyRevenueResponse, error)
+	GetTopMovies(limit int, cinemaIDs []uuid.UUID) ([]response.TopMovieResponse, error)
+	GetRevenueReport(groupBy string, cinemaIDs []uuid.UUID) ([]response.DailyRevenueResponse, error)
+	GetTicketTypeSales(cinemaIDs []uuid.UUID) ([]response.TicketTypeSalesResponse, error)
+	GetDayTypeRevenue(cinemaIDs []uuid.UUID) ([]response.DayTypeRevenueResponse, error)
 }
 
 type reportRepository struct {
@@ -21,13 +25,13 @@ func NewReportRepository(db *gorm.DB) ReportRepository {
 	return &reportRepository{db}
 }
 
-func (r *reportRepository) GetTopMovies(limit int) ([]response.TopMovieResponse, error) {
+func (r *reportRepository) GetTopMovies(limit int, cinemaIDs []uuid.UUID) ([]response.TopMovieResponse, error) {
 	var results []response.TopMovieResponse
 
 	// Query Join 4 Tabel: Transactions -> Tickets -> Schedules -> Movies
-	// Hitung jumlah tiket per film
-	err := r.db.Table("tickets").
-		Select("movies.id as movie_id, movies.title, COUNT(tickets.id) as total_sold, SUM(schedules.price) as total_sales").
+	// Hitung jumlah tiket per film. Pendapatan memakai harga terkunci per tiket, bukan harga jadwal saat ini.
+	err := filterTicketsByCinemas(r.db.Table("tickets"), cinemaIDs).
+		Select("movies.id as movie_id, movies.title, COUNT(tickets.id) as total_sold, SUM(tickets.price) as total_sales").
 		Joins("JOIN transactions ON transactions.id = tickets.transaction_id").
 		Joins("JOIN schedules ON schedules.id = tickets.schedule_id").
 		Joins("JOIN movies ON movies.id = schedules.movie_id").
@@ -40,7 +44,7 @@ func (r *reportRepository) GetTopMovies(limit int) ([]response.TopMovieResponse,
 	return results, err
 }
 
-func (r *reportRepository) GetRevenueReport(groupBy string) ([]response.DailyRevenueResponse, error) {
+func (r *reportRepository) GetRevenueReport(groupBy string, cinemaIDs []uuid.UUID) ([]response.DailyRevenueResponse, error) {
 	var results []response.DailyRevenueResponse
 	var dateFormat string
 
@@ -51,15 +55,90 @@ func (r *reportRepository) GetRevenueReport(groupBy string) ([]response.DailyRev
 		dateFormat = "YYYY-MM-DD" // Default Harian (2025-12-11)
 	}
 
-	// Query Dynamic
-	querySelect := fmt.Sprintf("TO_CHAR(created_at, '%s') as date, SUM(final_amount) as total_amount, COUNT(id) as count", dateFormat)
+	// Query Dynamic. Tanggal transaksi dihitung di zona waktu studio (created_at disimpan UTC)
+	querySelect := fmt.Sprintf("TO_CHAR(transactions.created_at AT TIME ZONE COALESCE(studio_tz.timezone, 'UTC'), '%s') as date, SUM(transactions.final_amount) as total_amount, COUNT(transactions.id) as count", dateFormat)
 
-	err := r.db.Table("transactions").
+	query := r.db.Table("transactions")
+	if cinemaIDs != nil {
+		query = query.Where(`EXISTS (
+			SELECT 1 FROM tickets
+			JOIN schedules ON schedules.id = tickets.schedule_id
+			JOIN studios ON studios.id = schedules.studio_id
+			WHERE tickets.transaction_id = transactions.id AND studios.cinema_id IN ?
+		)`, nullableIDs(cinemaIDs))
+	}
+
+	err := query.
 		Select(querySelect).
-		Where("status = ?", enums.TransactionPaid). // Hanya yang sudah lunas
+		Joins(`LEFT JOIN LATERAL (
+			SELECT studios.timezone FROM tickets
+			JOIN schedules ON schedules.id = tickets.schedule_id
+			JOIN studios ON studios.id = schedules.studio_id
+			WHERE tickets.transaction_id = transactions.id
+			LIMIT 1
+		) studio_tz ON TRUE`).
+		Where("transactions.status = ?", enums.TransactionPaid). // Hanya yang sudah lunas
 		Group("date").
 		Order("date DESC").
 		Scan(&results).Error
 
 	return results, err
 }
+
+func (r *reportRepository) GetTicketTypeSales(cinemaIDs []uuid.UUID) ([]response.TicketTypeSalesResponse, error) {
+	var results []response.TicketTypeSalesResponse
+
+	// Pakai snapshot kode di tiket agar tipe yang sudah dihapus tetap muncul
+	err := filterTicketsByCinemas(r.db.Table("tickets"), cinemaIDs).
+		Select("tickets.ticket_type_code as ticket_type, COALESCE(MAX(ticket_types.name), tickets.ticket_type_code) as name, COUNT(tickets.id) as total_sold, SUM(tickets.price) as total_sales").
+		Joins("JOIN transactions ON transactions.id = tickets.transaction_id").
+		Joins("LEFT JOIN ticket_types ON ticket_types.id = tickets.ticket_type_id").
+		Where("transactions.status = ?", enums.TransactionPaid).
+		Group("tickets.ticket_type_code").
+		Order("total_sold DESC").
+		Scan(&results).Error
+
+	return results, err
+}
+
+func (r *reportRepository) GetDayTypeRevenue(cinemaIDs []uuid.UUID) ([]response.DayTypeRevenueResponse, error) {
+	var results []response.DayTypeRevenueResponse
+
+	// Jenis hari diambil dari kalender berdasarkan tanggal tayang lokal studio. Jika 1 tanggal punya
+	// beberapa entri, holiday diprioritaskan. Tanggal tanpa entri = regular.
+	dayTypeJoin := fmt.Sprintf(`LEFT JOIN LATERAL (
+		SELECT cd.day_type FROM calendar_dates cd
+		WHERE cd.deleted_at IS NULL AND (
+			(cd.is_recurring = FALSE AND cd.date = (schedules.start_time AT TIME ZONE studios.timezone)::date) OR
+			(cd.is_recurring = TRUE AND EXTRACT(MONTH FROM cd.date) = EXTRACT(MONTH FROM schedules.start_time AT TIME ZONE studios.timezone)
+				AND EXTRACT(DAY FROM cd.date) = EXTRACT(DAY FROM schedules.start_time AT TIME ZONE studios.timezone))
+		)
+		ORDER BY CASE cd.day_type WHEN '%s' THEN 0 ELSE 1 END
+		LIMIT 1
+	) calendar ON TRUE`, enums.DayTypeHoliday)
+
+	err := filterTicketsByCinemas(r.db.Table("tickets"), cinemaIDs).
+		Select("COALESCE(calendar.day_type, ?) as day_type, COUNT(tickets.id) as total_sold, SUM(tickets.price) as total_sales", enums.DayTypeRegular).
+		Joins("JOIN transactions ON transactions.id = tickets.transaction_id").
+		Joins("JOIN schedules ON schedules.id = tickets.schedule_id").
+		Joins("JOIN studios ON studios.id = schedules.studio_id").
+		Joins(dayTypeJoin).
+		Where("transactions.status = ?", enums.TransactionPaid).
+		Group("1").
+		Order("total_sales DESC").
+		Scan(&results).Error
+
+	return results, err
+}
+
+// filterTicketsByCinemas membatasi query tabel tickets ke jadwal di studio milik cinemaIDs (nil = tanpa filter)
+func filterTicketsByCinemas(query *gorm.DB, cinemaIDs []uuid.UUID) *gorm.DB {
+	if cinemaIDs == nil {
+		return query
+	}
+	return query.Where(`tickets.schedule_id IN (
+		SELECT schedules.id FROM schedules
+		JOIN studios ON studios.id = schedules.studio_id
+		WHERE studios.cinema_id IN ?
+	)`, nullableIDs(cinemaIDs))
+}
@@ -1,21 +1,70 @@
 package repository
 
 import (
+	"errors"
+	"fmt"
 	"movie-app/internal/domain"
+	"movie-app/internal/enums"
 	"time"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
+// ErrScheduleNotActive: jadwal sudah dibatalkan (atau status berubah saat proses berjalan)
+var ErrScheduleNotActive = errors.New("schedule is not active")
+
+// ErrScheduleTicketsChanged: ada tiket baru terjual setelah rencana pindah kursi dihitung
+var ErrScheduleTicketsChanged = errors.New("tickets were sold while updating the schedule, please review and retry")
+
+// ErrScheduleOverlap: bentrok terdeteksi saat insert, mis. jadwal lain keburu dibuat setelah validasi usecase
+var ErrScheduleOverlap = errors.New("schedule overlaps with existing showtime")
+
+// ScheduleFilter dipakai FindAll. Field zero value berarti tidak difilter.
+type ScheduleFilter struct {
+	StartFrom time.Time // start_time >= StartFrom
+	StartTo   time.Time // start_time < StartTo
+	MovieID   uuid.UUID
+	StudioID  uuid.UUID
+	CinemaID  uuid.UUID // Semua studio di cinema tsb
+	// MinPrice & MaxPrice memfilter harga dasar (kolom price), bukan harga efektif setelah pricing rules
+	// yang bergantung kategori kursi, okupansi & waktu booking
+	MinPrice float64
+	MaxPrice float64
+	Status   string // Kosong = semua status
+
+	Format           string // 2d / 3d
+	Feature          string // Jadwal yang punya fitur tsb, mis. imax
+	AudioLanguage    string // Tidak case-sensitive
+	SubtitleLanguage string
+}
+
 type ScheduleRepository interface {
+	// Create & CreateMany mengecek ulang bentrok di dalam db transaction (baris studio dikunci) dan gagal
+	// dengan ErrScheduleOverlap. CreateMany menyimpan semua jadwal atau tidak sama sekali.
 	Create(schedule *domain.Schedule) error
+	CreateMany(schedules []domain.Schedule) error
 	Update(schedule *domain.Schedule) error
+	// UpdateWithSeatMoves menyimpan jadwal & memindahkan kursi tiket (ticket ID -> seat ID) dalam 1 db transaction
+	UpdateWithSeatMoves(schedule *domain.Schedule, seatMoves map[uuid.UUID]uuid.UUID) error
 	Delete(id uuid.UUID) error
 	FindByID(id uuid.UUID) (*domain.Schedule, error)
-	FindAll(page int, limit int) ([]domain.Schedule, int64, error)
-	// CheckOverlap mengecek apakah ada jadwal lain di studio yg sama pada rentang waktu tsb
+	// FindAll mengembalikan jadwal sesuai filter, urut dari yang paling dekat (start_time ASC)
+	FindAll(filter ScheduleFilter, page int, limit int) ([]domain.Schedule, int64, error)
+	// CheckOverlap mengecek apakah ada jadwal lain di studio yg sama pada rentang waktu tsb.
+	// Jeda bersih-bersih studio (cleaning_minutes) dihitung setelah setiap jadwal.
 	CheckOverlap(studioID uuid.UUID, startTime, endTime time.Time, excludeID uuid.UUID) (bool, error)
+	// FindActiveByStudio mengambil jadwal aktif di studio yang beririsan dengan rentang waktu, urut start_time
+	FindActiveByStudio(studioID uuid.UUID, from, to time.Time) ([]domain.Schedule, error)
+	// Cancel menandai jadwal active menjadi cancelled. Gagal dengan ErrScheduleNotActive jika sudah dibatalkan.
+	Cancel(id uuid.UUID, reason string, replacementID *uuid.UUID) error
+
+	// --- Riwayat Harga ---
+	CreatePriceHistory(history *domain.SchedulePriceHistory) error
+	// FindLatestPrice mengembalikan catatan harga terakhir untuk kategori kursi tsb (nil jika belum ada)
+	FindLatestPrice(scheduleID uuid.UUID, seatCategory string) (*domain.SchedulePriceHistory, error)
+	FindPriceHistory(scheduleID uuid.UUID) ([]domain.SchedulePriceHistory, error)
 }
 
 type scheduleRepository struct {
@@ -27,13 +76,110 @@ func NewScheduleRepository(db *gorm.DB) ScheduleRepository {
 }
 
 func (r *scheduleRepository) Create(schedule *domain.Schedule) error {
-	return r.db.Create(schedule).Error
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := lockAndCheckOverlap(tx, []domain.Schedule{*schedule}); err != nil {
+			return err
+		}
+		return tx.Create(schedule).Error
+	})
+}
+
+func (r *scheduleRepository) CreateMany(schedules []domain.Schedule) error {
+	if len(schedules) == 0 {
+		return nil
+	}
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := lockAndCheckOverlap(tx, schedules); err != nil {
+			return err
+		}
+		return tx.Omit("Studio", "Movie").Create(&schedules).Error
+	})
+}
+
+// lockAndCheckOverlap mengunci baris studio (SELECT ... FOR UPDATE, urut ID agar tidak deadlock) lalu
+// mengecek ulang bentrok di dalam db transaction yang sama dengan insert. Insert paralel di studio yang
+// sama jadi antri, sehingga tidak ada jadwal yang lolos di antara validasi usecase & commit.
+// Jadwal yang sudah punya ID (update) tidak dianggap bentrok dengan dirinya sendiri.
+// Bentrok antar jadwal dalam batch yang sama dicek oleh usecase.
+func lockAndCheckOverlap(tx *gorm.DB, schedules []domain.Schedule) error {
+	seen := make(map[uuid.UUID]bool)
+	studioIDs := make([]uuid.UUID, 0, len(schedules))
+	for _, s := range schedules {
+		if !seen[s.StudioID] {
+			seen[s.StudioID] = true
+			studioIDs = append(studioIDs, s.StudioID)
+		}
+	}
+
+	var locked []uuid.UUID
+	err := tx.Model(&domain.Studio{}).Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id IN ?", studioIDs).Order("id").Pluck("id", &locked).Error
+	if err != nil {
+		return err
+	}
+
+	for _, s := range schedules {
+		isOverlap, err := checkOverlap(tx, s.StudioID, s.StartTime, s.EndTime, s.ID)
+		if err != nil {
+			return err
+		}
+		if isOverlap {
+			return fmt.Errorf("%w (studio %s at %s)", ErrScheduleOverlap, s.StudioID, s.StartTime.UTC().Format(time.RFC3339))
+		}
+	}
+	return nil
 }
 
 func (r *scheduleRepository) Update(schedule *domain.Schedule) error {
 	return r.db.Save(schedule).Error
 }
 
+func (r *scheduleRepository) UpdateWithSeatMoves(schedule *domain.Schedule, seatMoves map[uuid.UUID]uuid.UUID) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		// 1. Kunci jadwal (booking baru menunggu, lihat CreateBooking) lalu cek ulang tiket aktif:
+		// rencana pindah kursi dihitung di luar transaction, tiket yang terjual setelahnya tidak ikut dipetakan
+		var current domain.Schedule
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id", "studio_id").
+			First(&current, "id = ?", schedule.ID).Error
+		if err != nil {
+			return err
+		}
+		if current.StudioID != schedule.StudioID {
+			var ticketIDs []uuid.UUID
+			err := tx.Model(&domain.Ticket{}).Where("schedule_id = ? AND released_at IS NULL", schedule.ID).
+				Pluck("id", &ticketIDs).Error
+			if err != nil {
+				return err
+			}
+			for _, id := range ticketIDs {
+				if _, ok := seatMoves[id]; !ok {
+					return ErrScheduleTicketsChanged
+				}
+			}
+		}
+
+		// 2. Kunci studio tujuan & cek ulang bentrok di waktu / studio baru (jadwal ini sendiri dikecualikan)
+		if err := lockAndCheckOverlap(tx, []domain.Schedule{*schedule}); err != nil {
+			return err
+		}
+
+		// Omit relasi: Studio yang ter-preload masih studio lama & bisa menimpa studio_id baru
+		if err := tx.Omit(clause.Associations).Save(schedule).Error; err != nil {
+			return err
+		}
+
+		for ticketID, seatID := range seatMoves {
+			err := tx.Model(&domain.Ticket{}).
+				Where("id = ? AND schedule_id = ?", ticketID, schedule.ID).
+				Update("seat_id", seatID).Error
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
 func (r *scheduleRepository) Delete(id uuid.UUID) error {
 	return r.db.Delete(&domain.Schedule{}, id).Error
 }
@@ -48,34 +194,130 @@ func (r *scheduleRepository) FindByID(id uuid.UUID) (*domain.Schedule, error) {
 	return &schedule, nil
 }
 
-func (r *scheduleRepository) FindAll(page int, limit int) ([]domain.Schedule, int64, error) {
+func (r *scheduleRepository) FindAll(filter ScheduleFilter, page int, limit int) ([]domain.Schedule, int64, error) {
 	var schedules []domain.Schedule
 	var total int64
 
-	if err := r.db.Model(&domain.Schedule{}).Count(&total).Error; err != nil {
+	query := r.db.Model(&domain.Schedule{})
+	if !filter.StartFrom.IsZero() {
+		query = query.Where("start_time >= ?", filter.StartFrom)
+	}
+	if !filter.StartTo.IsZero() {
+		query = query.Where("start_time < ?", filter.StartTo)
+	}
+	if filter.MovieID != uuid.Nil {
+		query = query.Where("movie_id = ?", filter.MovieID)
+	}
+	if filter.StudioID != uuid.Nil {
+		query = query.Where("studio_id = ?", filter.StudioID)
+	}
+	if filter.CinemaID != uuid.Nil {
+		query = query.Where("studio_id IN (?)", r.db.Model(&domain.Studio{}).Select("id").Where("cinema_id = ?", filter.CinemaID))
+	}
+	if filter.MinPrice > 0 {
+		query = query.Where("price >= ?", filter.MinPrice)
+	}
+	if filter.MaxPrice > 0 {
+		query = query.Where("price <= ?", filter.MaxPrice)
+	}
+	if filter.Format != "" {
+		query = query.Where("format = ?", filter.Format)
+	}
+	if filter.Feature != "" {
+		query = query.Where("? = ANY(features)", filter.Feature)
+	}
+	if filter.AudioLanguage != "" {
+		query = query.Where("LOWER(audio_language) = LOWER(?)", filter.AudioLanguage)
+	}
+	if filter.SubtitleLanguage != "" {
+		query = query.Where("LOWER(subtitle_language) = LOWER(?)", filter.SubtitleLanguage)
+	}
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+
+	if err := query.Count(&total).Error; err != nil {
 		return nil, 0, err
 	}
 
 	offset := (page - 1) * limit
-	err := r.db.Preload("Studio").Preload("Movie").
+	err := query.Preload("Studio").Preload("Movie").
 		Limit(limit).Offset(offset).
-		Order("start_time DESC").
+		Order("start_time ASC").
 		Find(&schedules).Error
 
 	return schedules, total, err
 }
 
 func (r *scheduleRepository) CheckOverlap(studioID uuid.UUID, startTime, endTime time.Time, excludeID uuid.UUID) (bool, error) {
+	return checkOverlap(r.db, studioID, startTime, endTime, excludeID)
+}
+
+// checkOverlap dipakai CheckOverlap & lockAndCheckOverlap (di dalam db transaction)
+func checkOverlap(db *gorm.DB, studioID uuid.UUID, startTime, endTime time.Time, excludeID uuid.UUID) (bool, error) {
 	var count int64
-	query := r.db.Model(&domain.Schedule{}).
-		Where("studio_id = ?", studioID).
-		Where("((start_time < ?) AND (end_time > ?))", endTime, startTime) // Logika Overlap
+	// Logika Overlap: [start, end + buffer) jadwal lama beririsan dengan [start, end + buffer) jadwal baru.
+	// Parameter di-cast ke timestamptz (bukan timestamp) agar offset zona waktu pemanggil tidak hilang.
+	query := db.Model(&domain.Schedule{}).
+		Joins("JOIN studios ON studios.id = schedules.studio_id").
+		Where("schedules.studio_id = ?", studioID).
+		Where("schedules.status != ?", enums.ScheduleCancelled). // Jadwal batal tidak memakai studio
+		Where("schedules.start_time < ?::timestamptz + studios.cleaning_minutes * INTERVAL '1 minute'", endTime.UTC()).
+		Where("schedules.end_time + studios.cleaning_minutes * INTERVAL '1 minute' > ?", startTime.UTC())
 
 	// Jika sedang Update, jangan anggap jadwal diri sendiri sebagai bentrok
 	if excludeID != uuid.Nil {
-		query = query.Where("id != ?", excludeID)
+		query = query.Where("schedules.id != ?", excludeID)
 	}
 
 	err := query.Count(&count).Error
 	return count > 0, err
 }
+
+func (r *scheduleRepository) FindActiveByStudio(studioID uuid.UUID, from, to time.Time) ([]domain.Schedule, error) {
+	var schedules []domain.Schedule
+	err := r.db.Where("studio_id = ? AND status = ?", studioID, enums.ScheduleActive).
+		Where("start_time < ? AND end_time > ?", to, from).
+		Order("start_time ASC").
+		Find(&schedules).Error
+	return schedules, err
+}
+
+func (r *scheduleRepository) Cancel(id uuid.UUID, reason string, replacementID *uuid.UUID) error {
+	result := r.db.Model(&domain.Schedule{}).
+		Where("id = ? AND status = ?", id, enums.ScheduleActive).
+		Updates(map[string]interface{}{
+			"status":                  enums.ScheduleCancelled,
+			"cancellation_reason":     reason,
+			"cancelled_at":            time.Now(),
+			"replacement_schedule_id": replacementID,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrScheduleNotActive
+	}
+	return nil
+}
+
+func (r *scheduleRepository) CreatePriceHistory(history *domain.SchedulePriceHistory) error {
+	return r.db.Create(history).Error
+}
+
+func (r *scheduleRepository) FindLatestPrice(scheduleID uuid.UUID, seatCategory string) (*domain.SchedulePriceHistory, error) {
+	var histories []domain.SchedulePriceHistory
+	err := r.db.Where("schedule_id = ? AND seat_category = ?", scheduleID, seatCategory).
+		Order("created_at DESC").Limit(1).
+		Find(&histories).Error
+	if err != nil || len(histories) == 0 {
+		return nil, err
+	}
+	return &histories[0], nil
+}
+
+func (r *scheduleRepository) FindPriceHistory(scheduleID uuid.UUID) ([]domain.SchedulePriceHistory, error) {
+	var histories []domain.SchedulePriceHistory
+	err := r.db.Where("schedule_id = ?", scheduleID).Order("created_at DESC").Find(&histories).Error
+	return histories, err
+}
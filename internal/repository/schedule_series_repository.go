@@ -0,0 +1,101 @@
+package repository
+
+import (
+	"movie-app/internal/domain"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type ScheduleSeriesRepository interface {
+	// Create menyimpan series beserta semua occurrence-nya dalam 1 db transaction.
+	// Bentrok dicek ulang di dalam transaction, jika ada dikembalikan ErrScheduleOverlap & tidak ada yang disimpan.
+	Create(series *domain.ScheduleSeries, schedules []domain.Schedule) error
+	Update(series *domain.ScheduleSeries) error
+	Delete(id uuid.UUID) error
+	FindByID(id uuid.UUID) (*domain.ScheduleSeries, error)
+	// FindOccurrences: from zero = semua occurrence, selain itu hanya yang mulai setelah from
+	FindOccurrences(seriesID uuid.UUID, from time.Time) ([]domain.Schedule, error)
+	// UpdatePrices mengubah harga occurrence yang dipilih dalam 1 db transaction
+	UpdatePrices(scheduleIDs []uuid.UUID, price, minPrice, maxPrice float64) error
+	// DeleteOccurrences menghapus occurrence yang dipilih dalam 1 db transaction
+	DeleteOccurrences(scheduleIDs []uuid.UUID) error
+}
+
+type scheduleSeriesRepository struct {
+	db *gorm.DB
+}
+
+func NewScheduleSeriesRepository(db *gorm.DB) ScheduleSeriesRepository {
+	return &scheduleSeriesRepository{db}
+}
+
+func (r *scheduleSeriesRepository) Create(series *domain.ScheduleSeries, schedules []domain.Schedule) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if len(schedules) > 0 {
+			if err := lockAndCheckOverlap(tx, schedules); err != nil {
+				return err
+			}
+		}
+		if err := tx.Omit("Schedules").Create(series).Error; err != nil {
+			return err
+		}
+		for i := range schedules {
+			schedules[i].SeriesID = &series.ID
+		}
+		if len(schedules) == 0 {
+			return nil
+		}
+		return tx.Omit("Studio", "Movie").Create(&schedules).Error
+	})
+}
+
+func (r *scheduleSeriesRepository) Update(series *domain.ScheduleSeries) error {
+	return r.db.Omit("Schedules").Save(series).Error
+}
+
+func (r *scheduleSeriesRepository) Delete(id uuid.UUID) error {
+	return r.db.Delete(&domain.ScheduleSeries{}, id).Error
+}
+
+func (r *scheduleSeriesRepository) FindByID(id uuid.UUID) (*domain.ScheduleSeries, error) {
+	var series domain.ScheduleSeries
+	err := r.db.First(&series, "id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &series, nil
+}
+
+func (r *scheduleSeriesRepository) FindOccurrences(seriesID uuid.UUID, from time.Time) ([]domain.Schedule, error) {
+	var schedules []domain.Schedule
+	query := r.db.Preload("Studio").Where("series_id = ?", seriesID)
+	if !from.IsZero() {
+		query = query.Where("start_time > ?", from)
+	}
+	err := query.Order("start_time ASC").Find(&schedules).Error
+	return schedules, err
+}
+
+func (r *scheduleSeriesRepository) UpdatePrices(scheduleIDs []uuid.UUID, price, minPrice, maxPrice float64) error {
+	if len(scheduleIDs) == 0 {
+		return nil
+	}
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		return tx.Model(&domain.Schedule{}).Where("id IN ?", scheduleIDs).Updates(map[string]interface{}{
+			"price":     price,
+			"min_price": minPrice,
+			"max_price": maxPrice,
+		}).Error
+	})
+}
+
+func (r *scheduleSeriesRepository) DeleteOccurrences(scheduleIDs []uuid.UUID) error {
+	if len(scheduleIDs) == 0 {
+		return nil
+	}
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		return tx.Where("id IN ?", scheduleIDs).Delete(&domain.Schedule{}).Error
+	})
+}
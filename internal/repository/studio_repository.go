@@ -2,8 +2,12 @@ package repository
 
 import (
 	"movie-app/internal/domain"
+	"movie-app/internal/enums"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 	"gorm.io/gorm"
 )
 
@@ -12,8 +16,16 @@ type StudioRepository interface {
 	Update(studio *domain.Studio) error
 	Delete(id uuid.UUID) error
 	FindByID(id uuid.UUID) (*domain.Studio, error)
-	FindAll(page int, limit int) ([]domain.Studio, int64, error)
+	// FindByName mencari studio dengan nama persis (tidak case-sensitive)
+	FindByName(name string) (*domain.Studio, error)
+	// FindAll: cinemaIDs nil = semua studio, slice kosong = tidak ada (admin tanpa cinema)
+	FindAll(cinemaIDs []uuid.UUID, page int, limit int) ([]domain.Studio, int64, error)
 	GetSeatsByStudioID(studioID uuid.UUID) ([]domain.Seat, error)
+	// FindSeatsByIDs hanya mengembalikan kursi yang memang milik studio tsb
+	FindSeatsByIDs(studioID uuid.UUID, seatIDs []uuid.UUID) ([]domain.Seat, error)
+	UpdateSeatCategory(studioID uuid.UUID, seatIDs []uuid.UUID, category string) (int64, error)
+	// CountUpcomingByFeatures menghitung jadwal aktif yang belum selesai & memakai salah satu fitur (3d = format 3D)
+	CountUpcomingByFeatures(studioID uuid.UUID, features []string, now time.Time) (int64, error)
 }
 
 type studioRepository struct {
@@ -45,16 +57,30 @@ func (r *studioRepository) FindByID(id uuid.UUID) (*domain.Studio, error) {
 	return &studio, nil
 }
 
-func (r *studioRepository) FindAll(page int, limit int) ([]domain.Studio, int64, error) {
+func (r *studioRepository) FindByName(name string) (*domain.Studio, error) {
+	var studio domain.Studio
+	err := r.db.First(&studio, "LOWER(name) = ?", strings.ToLower(strings.TrimSpace(name))).Error
+	if err != nil {
+		return nil, err
+	}
+	return &studio, nil
+}
+
+func (r *studioRepository) FindAll(cinemaIDs []uuid.UUID, page int, limit int) ([]domain.Studio, int64, error) {
 	var studios []domain.Studio
 	var total int64
 
-	if err := r.db.Model(&domain.Studio{}).Count(&total).Error; err != nil {
+	query := r.db.Model(&domain.Studio{})
+	if cinemaIDs != nil {
+		query = query.Where("cinema_id IN ?", nullableIDs(cinemaIDs))
+	}
+
+	if err := query.Count(&total).Error; err != nil {
 		return nil, 0, err
 	}
 
 	offset := (page - 1) * limit
-	err := r.db.Limit(limit).Offset(offset).Find(&studios).Error
+	err := query.Limit(limit).Offset(offset).Find(&studios).Error
 
 	return studios, total, err
 }
@@ -64,3 +90,33 @@ func (r *studioRepository) GetSeatsByStudioID(studioID uuid.UUID) ([]domain.Seat
 	err := r.db.Where("studio_id = ?", studioID).Order("row_code, seat_number").Find(&seats).Error
 	return seats, err
 }
+
+func (r *studioRepository) FindSeatsByIDs(studioID uuid.UUID, seatIDs []uuid.UUID) ([]domain.Seat, error) {
+	var seats []domain.Seat
+	err := r.db.Where("studio_id = ? AND id IN ?", studioID, seatIDs).Order("row_code, seat_number").Find(&seats).Error
+	return seats, err
+}
+
+func (r *studioRepository) UpdateSeatCategory(studioID uuid.UUID, seatIDs []uuid.UUID, category string) (int64, error) {
+	result := r.db.Model(&domain.Seat{}).
+		Where("studio_id = ? AND id IN ?", studioID, seatIDs).
+		Update("category", category)
+	return result.RowsAffected, result.Error
+}
+
+func (r *studioRepository) CountUpcomingByFeatures(studioID uuid.UUID, features []string, now time.Time) (int64, error) {
+	var count int64
+	err := r.db.Model(&domain.Schedule{}).
+		Where("studio_id = ? AND status = ? AND end_time > ?", studioID, enums.ScheduleActive, now).
+		Where("features && ? OR (format = ? AND ? = ANY(?))", pq.Array(features), enums.Format3D, enums.Feature3D, pq.Array(features)).
+		Count(&count).Error
+	return count, err
+}
+
+// nullableIDs menjaga "IN ?" tetap valid untuk slice kosong (tidak ada baris yang cocok)
+func nullableIDs(ids []uuid.UUID) []uuid.UUID {
+	if len(ids) == 0 {
+		return []uuid.UUID{uuid.Nil}
+	}
+	return ids
+}
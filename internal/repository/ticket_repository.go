@@ -1,18 +1,29 @@
 package repository
 
 import (
+	"errors"
 	"movie-app/internal/domain"
 	"movie-app/internal/enums"
+	"time"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
+// ErrBookingScheduleChanged dikembalikan jika studio jadwal diganti saat booking sedang diproses
+var ErrBookingScheduleChanged = errors.New("schedule was changed while booking, please choose your seats again")
+
 type TicketRepository interface {
 	// GetBookedSeats mengambil daftar kursi yang SUDAH laku untuk jadwal tertentu
 	GetBookedSeats(scheduleID uuid.UUID) ([]domain.Ticket, error)
+	// CountBookedSeats menghitung kursi terjual untuk banyak jadwal sekaligus (key = schedule ID)
+	CountBookedSeats(scheduleIDs []uuid.UUID) (map[uuid.UUID]int, error)
 	GetByUserID(userID uuid.UUID) ([]domain.Transaction, error) // History
-	// CreateBooking melakukan insert Transaction & Tickets dalam 1 db transaction
+	// HasPaidTransaction dipakai untuk promo khusus pembeli pertama
+	HasPaidTransaction(userID uuid.UUID) (bool, error)
+	// CreateBooking melakukan insert Transaction & Tickets dalam 1 db transaction.
+	// Jika memakai promo, kuota dicek & redemption dicatat di transaction yang sama.
 	CreateBooking(tx *domain.Transaction) error
 }
 
@@ -26,33 +37,171 @@ func NewTicketRepository(db *gorm.DB) TicketRepository {
 
 func (r *ticketRepository) GetBookedSeats(scheduleID uuid.UUID) ([]domain.Ticket, error) {
 	var tickets []domain.Ticket
-	// Ganti domain.TransactionCancel menjadi enums.TransactionCancelled
-	err := r.db.Joins("JOIN transactions ON transactions.id = tickets.transaction_id").
-		Where("tickets.schedule_id = ? AND transactions.status != ?", scheduleID, enums.TransactionCancel).
+	// Kursi dari transaksi yang dibatalkan / di-refund (released) dianggap kosong lagi,
+	// sama dengan unique index idx_tickets_active_seat
+	err := r.db.Where("schedule_id = ? AND released_at IS NULL", scheduleID).
 		Find(&tickets).Error
 	return tickets, err
 }
 
+func (r *ticketRepository) CountBookedSeats(scheduleIDs []uuid.UUID) (map[uuid.UUID]int, error) {
+	counts := make(map[uuid.UUID]int, len(scheduleIDs))
+	if len(scheduleIDs) == 0 {
+		return counts, nil
+	}
+
+	var rows []struct {
+		ScheduleID uuid.UUID
+		Booked     int
+	}
+	err := r.db.Model(&domain.Ticket{}).
+		Select("tickets.schedule_id, COUNT(*) AS booked").
+		Where("tickets.schedule_id IN ? AND tickets.released_at IS NULL", scheduleIDs).
+		Group("tickets.schedule_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		counts[row.ScheduleID] = row.Booked
+	}
+	return counts, nil
+}
+
 func (r *ticketRepository) GetByUserID(userID uuid.UUID) ([]domain.Transaction, error) {
 	var transactions []domain.Transaction
-	err := r.db.Preload("Tickets.Seat").Preload("Tickets.Schedule.Movie").Preload("Tickets.Schedule.Studio").
+	err := r.db.Preload("Tickets.Seat").Preload("Tickets.TicketType").Preload("Tickets.Schedule.Movie").Preload("Tickets.Schedule.Studio").
 		Where("user_id = ?", userID).
 		Order("created_at DESC").
 		Find(&transactions).Error
 	return transactions, err
 }
 
+func (r *ticketRepository) HasPaidTransaction(userID uuid.UUID) (bool, error) {
+	var count int64
+	err := r.db.Model(&domain.Transaction{}).
+		Where("user_id = ? AND status = ?", userID, enums.TransactionPaid).
+		Count(&count).Error
+	return count > 0, err
+}
+
 func (r *ticketRepository) CreateBooking(transaction *domain.Transaction) error {
 	// GORM Transaction: Atomic Operation
 	return r.db.Transaction(func(tx *gorm.DB) error {
-		// 1. Create Header Transaction
+		// 1. Kunci baris promo (SELECT ... FOR UPDATE) agar booking paralel antri,
+		// sehingga hitungan kuota tidak bisa dilewati
+		if transaction.PromoID != nil {
+			if err := checkPromoQuota(tx, *transaction.PromoID, transaction.UserID); err != nil {
+				return err
+			}
+		}
+
+		// Voucher sekali pakai: kunci barisnya supaya tidak bisa dipakai 2 booking sekaligus
+		if transaction.VoucherCodeID != nil {
+			var voucher domain.VoucherCode
+			err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+				Where("id = ? AND redeemed_at IS NULL AND revoked_at IS NULL", *transaction.VoucherCodeID).
+				First(&voucher).Error
+			if err != nil {
+				return ErrVoucherAlreadyUsed
+			}
+		}
+
+		// Kunci jadwal (FOR SHARE): update jadwal yang memindah kursi menunggu booking ini selesai,
+		// dan kursi dipastikan masih milik studio jadwal saat ini
+		if err := checkSeatsInScheduleStudio(tx, transaction.Tickets); err != nil {
+			return err
+		}
+
+		// 2. Create Header Transaction
 		if err := tx.Create(transaction).Error; err != nil {
 			return err
 		}
 
-		// 2. Create Detail Tickets (otomatis karena relasi HasMany)
+		if transaction.VoucherCodeID != nil {
+			err := tx.Model(&domain.VoucherCode{}).Where("id = ?", *transaction.VoucherCodeID).Updates(map[string]interface{}{
+				"redeemed_by":    transaction.UserID,
+				"redeemed_at":    time.Now(),
+				"transaction_id": transaction.ID,
+			}).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		// 3. Create Detail Tickets (otomatis karena relasi HasMany)
 		// GORM cukup pintar, jika struct transaction punya field Tickets terisi,
 		// dia akan insert ke tabel tickets juga.
+
+		// 4. Catat pemakaian promo
+		if transaction.PromoID != nil {
+			redemption := &domain.PromoRedemption{
+				PromoID:        *transaction.PromoID,
+				UserID:         transaction.UserID,
+				TransactionID:  transaction.ID,
+				DiscountAmount: transaction.DiscountAmount,
+				Status:         enums.RedemptionReserved,
+			}
+			if err := tx.Create(redemption).Error; err != nil {
+				return err
+			}
+		}
 		return nil
 	})
 }
+
+// checkPromoQuota harus dipanggil di dalam db transaction
+func checkPromoQuota(tx *gorm.DB, promoID uuid.UUID, userID uuid.UUID) error {
+	var promo domain.Promo
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&promo, "id = ?", promoID).Error; err != nil {
+		return err
+	}
+
+	if promo.UsageLimit > 0 {
+		var used int64
+		if err := tx.Model(&domain.PromoRedemption{}).Where("promo_id = ? AND status != ?", promoID, enums.RedemptionReleased).Count(&used).Error; err != nil {
+			return err
+		}
+		if used >= int64(promo.UsageLimit) {
+			return ErrPromoUsageLimitReached
+		}
+	}
+
+	if promo.PerUserLimit > 0 {
+		var usedByUser int64
+		if err := tx.Model(&domain.PromoRedemption{}).Where("promo_id = ? AND user_id = ? AND status != ?", promoID, userID, enums.RedemptionReleased).Count(&usedByUser).Error; err != nil {
+			return err
+		}
+		if usedByUser >= int64(promo.PerUserLimit) {
+			return ErrPromoUserLimitReached
+		}
+	}
+	return nil
+}
+
+func checkSeatsInScheduleStudio(tx *gorm.DB, tickets []domain.Ticket) error {
+	if len(tickets) == 0 {
+		return nil
+	}
+	var schedule domain.Schedule
+	err := tx.Clauses(clause.Locking{Strength: "SHARE"}).Select("id", "studio_id").
+		First(&schedule, "id = ?", tickets[0].ScheduleID).Error
+	if err != nil {
+		return err
+	}
+
+	seatIDs := make([]uuid.UUID, 0, len(tickets))
+	for _, t := range tickets {
+		seatIDs = append(seatIDs, t.SeatID)
+	}
+	var count int64
+	err = tx.Model(&domain.Seat{}).Where("id IN ? AND studio_id = ?", seatIDs, schedule.StudioID).Count(&count).Error
+	if err != nil {
+		return err
+	}
+	if int(count) != len(seatIDs) {
+		return ErrBookingScheduleChanged
+	}
+	return nil
+}
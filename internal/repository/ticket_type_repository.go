@@ -0,0 +1,68 @@
+package repository
+
+import (
+	"movie-app/internal/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type TicketTypeRepository interface {
+	Create(ticketType *domain.TicketType) error
+	Update(ticketType *domain.TicketType) error
+	Delete(id uuid.UUID) error
+	FindByID(id uuid.UUID) (*domain.TicketType, error)
+	FindByCode(code string) (*domain.TicketType, error)
+	FindAll() ([]domain.TicketType, error)
+	FindActive() ([]domain.TicketType, error)
+}
+
+type ticketTypeRepository struct {
+	db *gorm.DB
+}
+
+func NewTicketTypeRepository(db *gorm.DB) TicketTypeRepository {
+	return &ticketTypeRepository{db}
+}
+
+func (r *ticketTypeRepository) Create(ticketType *domain.TicketType) error {
+	return r.db.Create(ticketType).Error
+}
+
+func (r *ticketTypeRepository) Update(ticketType *domain.TicketType) error {
+	return r.db.Save(ticketType).Error
+}
+
+func (r *ticketTypeRepository) Delete(id uuid.UUID) error {
+	return r.db.Delete(&domain.TicketType{}, id).Error
+}
+
+func (r *ticketTypeRepository) FindByID(id uuid.UUID) (*domain.TicketType, error) {
+	var ticketType domain.TicketType
+	err := r.db.First(&ticketType, "id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &ticketType, nil
+}
+
+func (r *ticketTypeRepository) FindByCode(code string) (*domain.TicketType, error) {
+	var ticketType domain.TicketType
+	err := r.db.Where("code = ?", code).First(&ticketType).Error
+	if err != nil {
+		return nil, err
+	}
+	return &ticketType, nil
+}
+
+func (r *ticketTypeRepository) FindAll() ([]domain.TicketType, error) {
+	var ticketTypes []domain.TicketType
+	err := r.db.Order("sort_order, name").Find(&ticketTypes).Error
+	return ticketTypes, err
+}
+
+func (r *ticketTypeRepository) FindActive() ([]domain.TicketType, error) {
+	var ticketTypes []domain.TicketType
+	err := r.db.Where("is_active = ?", true).Order("sort_order, name").Find(&ticketTypes).Error
+	return ticketTypes, err
+}
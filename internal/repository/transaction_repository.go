@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"errors"
 	"movie-app/internal/domain"
 	"movie-app/internal/enums"
 	"time"
@@ -9,11 +10,28 @@ import (
 	"gorm.io/gorm"
 )
 
+// ErrTransactionStatusChanged: status transaksi sudah berubah (mis. keburu dibayar / di-cancel worker)
+var ErrTransactionStatusChanged = errors.New("transaction status has changed, please reload")
+
 type TransactionRepository interface {
 	FindByID(id uuid.UUID) (*domain.Transaction, error)
 	UpdateStatus(id uuid.UUID, status enums.TransactionStatus, paymentMethod string) error
+	// MarkAsPaid menyimpan metode, biaya pembayaran, dan total akhir sekaligus,
+	// lalu menandai pemakaian promo sebagai consumed
+	MarkAsPaid(id uuid.UUID, paymentMethod string, paymentFee float64, finalAmount float64) error
+	// ReleaseTransaction mengubah status (cancel / refund) dan mengembalikan kuota promo & voucher
+	// dalam 1 db transaction. Gagal jika status saat ini bukan fromStatus.
+	ReleaseTransaction(id uuid.UUID, fromStatus, toStatus enums.TransactionStatus) error
+	// RefundToCredit me-refund transaksi paid (seperti ReleaseTransaction) lalu menambahkan
+	// final_amount ke saldo kredit user & mencatat riwayatnya, semuanya dalam 1 db transaction.
+	RefundToCredit(id uuid.UUID, reason string) error
+	// FindBySchedule mengambil transaksi yang punya tiket di jadwal tsb dengan status tertentu
+	FindBySchedule(scheduleID uuid.UUID, statuses []enums.TransactionStatus) ([]domain.Transaction, error)
 	GetByUserID(userID uuid.UUID) ([]domain.Transaction, error)
-	GetExpiredPendingTransactions(threshold time.Time) ([]domain.Transaction, error)
+	GetExpiredPendingTransactions(now time.Time) ([]domain.Transaction, error)
+	// UpdateExpiresAt hanya untuk transaksi yang masih pending, gagal dengan ErrTransactionStatusChanged
+	// jika keburu dibayar / di-cancel
+	UpdateExpiresAt(id uuid.UUID, expiresAt time.Time) error
 	GetUpcomingPaidTransactions(startTime, endTime time.Time) ([]domain.Transaction, error)
 	MarkReminderSent(id uuid.UUID) error
 }
@@ -34,7 +52,9 @@ func (r *transactionRepository) FindByID(id uuid.UUID) (*domain.Transaction, err
 	err := r.db.
 		Preload("User").
 		Preload("Tickets.Seat").
+		Preload("Tickets.TicketType").
 		Preload("Tickets.Schedule.Movie").
+		Preload("Tickets.Schedule.Studio").
 		First(&transaction, "id = ?", id).Error
 
 	if err != nil {
@@ -50,6 +70,115 @@ func (r *transactionRepository) UpdateStatus(id uuid.UUID, status enums.Transact
 	}).Error
 }
 
+func (r *transactionRepository) MarkAsPaid(id uuid.UUID, paymentMethod string, paymentFee float64, finalAmount float64) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&domain.Transaction{}).
+			Where("id = ? AND status = ?", id, enums.TransactionPending).
+			Updates(map[string]interface{}{
+				"status":         enums.TransactionPaid,
+				"payment_method": paymentMethod,
+				"payment_fee":    paymentFee,
+				"final_amount":   finalAmount,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return ErrTransactionStatusChanged
+		}
+
+		return tx.Model(&domain.PromoRedemption{}).
+			Where("transaction_id = ? AND status = ?", id, enums.RedemptionReserved).
+			Update("status", enums.RedemptionConsumed).Error
+	})
+}
+
+func (r *transactionRepository) ReleaseTransaction(id uuid.UUID, fromStatus, toStatus enums.TransactionStatus) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		return releaseTransaction(tx, id, fromStatus, toStatus)
+	})
+}
+
+func (r *transactionRepository) RefundToCredit(id uuid.UUID, reason string) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		var transaction domain.Transaction
+		if err := tx.First(&transaction, "id = ?", id).Error; err != nil {
+			return err
+		}
+
+		if err := releaseTransaction(tx, id, enums.TransactionPaid, enums.TransactionRefund); err != nil {
+			return err
+		}
+
+		credit := &domain.UserCredit{
+			UserID:        transaction.UserID,
+			TransactionID: &transaction.ID,
+			Amount:        transaction.FinalAmount,
+			Reason:        reason,
+		}
+		if err := tx.Create(credit).Error; err != nil {
+			return err
+		}
+
+		return tx.Model(&domain.User{}).Where("id = ?", transaction.UserID).
+			Update("credit_balance", gorm.Expr("credit_balance + ?", transaction.FinalAmount)).Error
+	})
+}
+
+// releaseTransaction dipakai ReleaseTransaction & RefundToCredit di dalam db transaction yang sama
+func releaseTransaction(tx *gorm.DB, id uuid.UUID, fromStatus, toStatus enums.TransactionStatus) error {
+	// 1. Update status hanya jika masih di status asal (cegah double release)
+	result := tx.Model(&domain.Transaction{}).
+		Where("id = ? AND status = ?", id, fromStatus).
+		Update("status", toStatus)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrTransactionStatusChanged
+	}
+
+	// 2. Lepas kursi agar bisa dibooking ulang (baris tiket tetap ada untuk riwayat)
+	err := tx.Model(&domain.Ticket{}).
+		Where("transaction_id = ? AND released_at IS NULL", id).
+		Update("released_at", time.Now()).Error
+	if err != nil {
+		return err
+	}
+
+	// 3. Kembalikan kuota promo
+	err = tx.Model(&domain.PromoRedemption{}).
+		Where("transaction_id = ? AND status != ?", id, enums.RedemptionReleased).
+		Updates(map[string]interface{}{
+			"status":      enums.RedemptionReleased,
+			"released_at": time.Now(),
+		}).Error
+	if err != nil {
+		return err
+	}
+
+	// 4. Voucher sekali pakai bisa dipakai lagi
+	return tx.Model(&domain.VoucherCode{}).
+		Where("transaction_id = ?", id).
+		Updates(map[string]interface{}{
+			"redeemed_by":    nil,
+			"redeemed_at":    nil,
+			"transaction_id": nil,
+		}).Error
+}
+
+func (r *transactionRepository) FindBySchedule(scheduleID uuid.UUID, statuses []enums.TransactionStatus) ([]domain.Transaction, error) {
+	var transactions []domain.Transaction
+	err := r.db.
+		Preload("User").
+		Preload("Tickets.Seat").
+		Preload("Tickets.Schedule.Movie").
+		Where("status IN ?", statuses).
+		Where("id IN (?)", r.db.Model(&domain.Ticket{}).Select("transaction_id").Where("schedule_id = ?", scheduleID)).
+		Find(&transactions).Error
+	return transactions, err
+}
+
 func (r *transactionRepository) GetByUserID(userID uuid.UUID) ([]domain.Transaction, error) {
 	var transactions []domain.Transaction
 	err := r.db.Preload("Tickets.Seat").
@@ -60,14 +189,27 @@ func (r *transactionRepository) GetByUserID(userID uuid.UUID) ([]domain.Transact
 	return transactions, err
 }
 
-func (r *transactionRepository) GetExpiredPendingTransactions(threshold time.Time) ([]domain.Transaction, error) {
+func (r *transactionRepository) GetExpiredPendingTransactions(now time.Time) ([]domain.Transaction, error) {
 	var transactions []domain.Transaction
-	// Query: Status = Pending AND CreatedAt < (Waktu Sekarang - 15 menit)
-	err := r.db.Where("status = ? AND created_at < ?", enums.TransactionPending, threshold).
+	// Query: Status = Pending AND batas waktu pembayaran (expires_at) sudah lewat
+	err := r.db.Where("status = ? AND expires_at < ?", enums.TransactionPending, now).
 		Find(&transactions).Error
 	return transactions, err
 }
 
+func (r *transactionRepository) UpdateExpiresAt(id uuid.UUID, expiresAt time.Time) error {
+	result := r.db.Model(&domain.Transaction{}).
+		Where("id = ? AND status = ?", id, enums.TransactionPending).
+		Update("expires_at", expiresAt)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrTransactionStatusChanged
+	}
+	return nil
+}
+
 func (r *transactionRepository) GetUpcomingPaidTransactions(startTime, endTime time.Time) ([]domain.Transaction, error) {
 	var transactions []domain.Transaction
 	// Join ke Schedule -> Preload User (untuk email) & Movie (untuk judul film)
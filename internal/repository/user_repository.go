@@ -11,6 +11,14 @@ type UserRepository interface {
 	Create(user *domain.User) error
 	FindByEmail(email string) (*domain.User, error)
 	FindByID(id uuid.UUID) (*domain.User, error)
+	// FindByCalendarToken dipakai feed .ics tiket (autentikasi lewat token di URL)
+	FindByCalendarToken(token string) (*domain.User, error)
+	UpdateCalendarToken(userID uuid.UUID, token string) error
+
+	// --- Scope Admin Cabang ---
+	FindCinemaIDs(userID uuid.UUID) ([]uuid.UUID, error)
+	// ReplaceCinemas mengganti seluruh cinema yang di-assign ke admin
+	ReplaceCinemas(user *domain.User, cinemas []domain.Cinema) error
 }
 
 type userRepository struct {
@@ -39,3 +47,29 @@ func (r *userRepository) FindByID(id uuid.UUID) (*domain.User, error) {
 	err := r.db.Where("id = ?", id).First(&user).Error
 	return &user, err
 }
+
+func (r *userRepository) FindByCalendarToken(token string) (*domain.User, error) {
+	var user domain.User
+	err := r.db.Where("calendar_token = ?", token).First(&user).Error
+	if err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+func (r *userRepository) UpdateCalendarToken(userID uuid.UUID, token string) error {
+	return r.db.Model(&domain.User{}).Where("id = ?", userID).Update("calendar_token", token).Error
+}
+
+func (r *userRepository) FindCinemaIDs(userID uuid.UUID) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	err := r.db.Table("admin_cinemas").Where("user_id = ?", userID).Pluck("cinema_id", &ids).Error
+	return ids, err
+}
+
+func (r *userRepository) ReplaceCinemas(user *domain.User, cinemas []domain.Cinema) error {
+	if len(cinemas) == 0 {
+		return r.db.Model(user).Association("Cinemas").Clear()
+	}
+	return r.db.Model(user).Association("Cinemas").Replace(cinemas)
+}
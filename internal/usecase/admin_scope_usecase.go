@@ -0,0 +1,107 @@
+package usecase
+
+import (
+	"errors"
+	"movie-app/internal/domain"
+	"movie-app/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+// ErrOutsideAdminScope: resource milik cinema yang tidak di-assign ke admin
+var ErrOutsideAdminScope = errors.New("access denied: outside your cinemas")
+
+// AdminScopeUseCase memeriksa apakah resource berada dalam cinema admin cabang.
+// Resource yang tidak ditemukan dianggap lolos agar handler tetap mengembalikan 404 seperti biasa.
+type AdminScopeUseCase interface {
+	// CinemaIDsOf membaca cinema admin cabang dari admin_cinemas (dipanggil tiap request,
+	// agar pencabutan akses langsung berlaku tanpa menunggu token kedaluwarsa)
+	CinemaIDsOf(userID uuid.UUID) ([]uuid.UUID, error)
+	CheckCinema(scope domain.AdminScope, cinemaID uuid.UUID) error
+	CheckStudio(scope domain.AdminScope, studioID uuid.UUID) error
+	CheckSchedule(scope domain.AdminScope, scheduleID uuid.UUID) error
+	CheckSeries(scope domain.AdminScope, seriesID uuid.UUID) error
+	// CheckTransaction memeriksa jadwal dari tiket-tiket transaksi
+	CheckTransaction(scope domain.AdminScope, transactionID uuid.UUID) error
+}
+
+type adminScopeUseCase struct {
+	userRepo        repository.UserRepository
+	studioRepo      repository.StudioRepository
+	scheduleRepo    repository.ScheduleRepository
+	seriesRepo      repository.ScheduleSeriesRepository
+	transactionRepo repository.TransactionRepository
+}
+
+func NewAdminScopeUseCase(
+	userRepo repository.UserRepository,
+	studioRepo repository.StudioRepository,
+	scheduleRepo repository.ScheduleRepository,
+	seriesRepo repository.ScheduleSeriesRepository,
+	transactionRepo repository.TransactionRepository,
+) AdminScopeUseCase {
+	return &adminScopeUseCase{userRepo, studioRepo, scheduleRepo, seriesRepo, transactionRepo}
+}
+
+func (uc *adminScopeUseCase) CinemaIDsOf(userID uuid.UUID) ([]uuid.UUID, error) {
+	return uc.userRepo.FindCinemaIDs(userID)
+}
+
+func (uc *adminScopeUseCase) CheckCinema(scope domain.AdminScope, cinemaID uuid.UUID) error {
+	if !scope.AllowsCinema(&cinemaID) {
+		return ErrOutsideAdminScope
+	}
+	return nil
+}
+
+func (uc *adminScopeUseCase) CheckStudio(scope domain.AdminScope, studioID uuid.UUID) error {
+	if scope.Global {
+		return nil
+	}
+	studio, err := uc.studioRepo.FindByID(studioID)
+	if err != nil {
+		return nil
+	}
+	if !scope.AllowsCinema(studio.CinemaID) {
+		return ErrOutsideAdminScope
+	}
+	return nil
+}
+
+func (uc *adminScopeUseCase) CheckSchedule(scope domain.AdminScope, scheduleID uuid.UUID) error {
+	if scope.Global {
+		return nil
+	}
+	schedule, err := uc.scheduleRepo.FindByID(scheduleID)
+	if err != nil {
+		return nil
+	}
+	return uc.CheckStudio(scope, schedule.StudioID)
+}
+
+func (uc *adminScopeUseCase) CheckSeries(scope domain.AdminScope, seriesID uuid.UUID) error {
+	if scope.Global {
+		return nil
+	}
+	series, err := uc.seriesRepo.FindByID(seriesID)
+	if err != nil {
+		return nil
+	}
+	return uc.CheckStudio(scope, series.StudioID)
+}
+
+func (uc *adminScopeUseCase) CheckTransaction(scope domain.AdminScope, transactionID uuid.UUID) error {
+	if scope.Global {
+		return nil
+	}
+	trx, err := uc.transactionRepo.FindByID(transactionID)
+	if err != nil {
+		return nil
+	}
+	for _, t := range trx.Tickets {
+		if err := uc.CheckStudio(scope, t.Schedule.StudioID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -2,6 +2,7 @@ package usecase
 
 import (
 	"errors"
+	"fmt"
 	"movie-app/internal/config"
 	"movie-app/internal/delivery/http/dto/request"
 	"movie-app/internal/delivery/http/dto/response"
@@ -17,18 +18,24 @@ import (
 
 type AuthUseCase interface {
 	Register(req request.RegisterRequest) (*domain.User, error)
+	// RegisterAdmin membuat admin cabang (tanpa cinema sampai di-assign), dipanggil oleh super admin
 	RegisterAdmin(req request.RegisterRequest) (*domain.User, error)
+	// CreateSuperAdmin hanya dipakai CLI cmd/superadmin untuk bootstrap super admin pertama
+	CreateSuperAdmin(req request.RegisterRequest) (*domain.User, error)
 	Login(req request.LoginRequest) (*response.AuthResponse, error)
 	GetProfile(user_id uuid.UUID) (*domain.User, error)
+	// SetAdminCinemas mengganti cinema yang dikelola admin cabang (langsung berlaku di request berikutnya)
+	SetAdminCinemas(adminID uuid.UUID, req request.SetAdminCinemasRequest) (*domain.User, error)
 }
 
 type authUseCase struct {
-	userRepo repository.UserRepository
-	cfg      *config.Config
+	userRepo   repository.UserRepository
+	cinemaRepo repository.CinemaRepository
+	cfg        *config.Config
 }
 
-func NewAuthUseCase(userRepo repository.UserRepository, cfg *config.Config) AuthUseCase {
-	return &authUseCase{userRepo, cfg}
+func NewAuthUseCase(userRepo repository.UserRepository, cinemaRepo repository.CinemaRepository, cfg *config.Config) AuthUseCase {
+	return &authUseCase{userRepo, cinemaRepo, cfg}
 }
 
 func (uc *authUseCase) Register(req request.RegisterRequest) (*domain.User, error) {
@@ -61,9 +68,15 @@ func (uc *authUseCase) Register(req request.RegisterRequest) (*domain.User, erro
 }
 
 func (uc *authUseCase) RegisterAdmin(req request.RegisterRequest) (*domain.User, error) {
-	// ⚠️ WARNING: Method ini hanya untuk Development/Seeding awal.
-	// Sebaiknya dihapus atau diproteksi key khusus saat production.
+	// Cinema di-assign terpisah lewat PUT /admins/:id/cinemas
+	return uc.createAdmin(req, enums.RoleAdmin)
+}
+
+func (uc *authUseCase) CreateSuperAdmin(req request.RegisterRequest) (*domain.User, error) {
+	return uc.createAdmin(req, enums.RoleSuperAdmin)
+}
 
+func (uc *authUseCase) createAdmin(req request.RegisterRequest, role enums.Role) (*domain.User, error) {
 	existingUser, _ := uc.userRepo.FindByEmail((req.Email))
 	if existingUser != nil {
 		return nil, errors.New("email already exists")
@@ -78,7 +91,7 @@ func (uc *authUseCase) RegisterAdmin(req request.RegisterRequest) (*domain.User,
 		Name:     req.Name,
 		Email:    req.Email,
 		Password: string(hashedPassword),
-		Role:     enums.RoleAdmin,
+		Role:     role,
 	}
 
 	if err := uc.userRepo.Create(user); err != nil {
@@ -93,6 +106,15 @@ func (uc *authUseCase) GetProfile(user_id uuid.UUID) (*domain.User, error) {
 	if err != nil {
 		return nil, errors.New("user not found")
 	}
+	if user.Role == enums.RoleAdmin {
+		ids, err := uc.userRepo.FindCinemaIDs(user.ID)
+		if err != nil {
+			return nil, err
+		}
+		for _, id := range ids {
+			user.Cinemas = append(user.Cinemas, domain.Cinema{BaseModel: domain.BaseModel{ID: id}})
+		}
+	}
 	return user, nil
 }
 
@@ -129,3 +151,35 @@ func (uc *authUseCase) generateJWT(user *domain.User) (string, error) {
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
 	return token.SignedString([]byte(uc.cfg.JWTSecret))
 }
+
+func (uc *authUseCase) SetAdminCinemas(adminID uuid.UUID, req request.SetAdminCinemasRequest) (*domain.User, error) {
+	user, err := uc.userRepo.FindByID(adminID)
+	if err != nil {
+		return nil, errors.New("user not found")
+	}
+	if user.Role != enums.RoleAdmin {
+		return nil, errors.New("cinemas can only be assigned to admin accounts")
+	}
+
+	cinemas := make([]domain.Cinema, 0, len(req.CinemaIDs))
+	seen := make(map[uuid.UUID]bool)
+	for _, idStr := range req.CinemaIDs {
+		id, _ := uuid.Parse(idStr)
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		cinema, err := uc.cinemaRepo.FindByID(id)
+		if err != nil {
+			return nil, fmt.Errorf("cinema %s not found", idStr)
+		}
+		cinema.Studios = nil // Hanya relasi admin_cinemas yang disimpan
+		cinemas = append(cinemas, *cinema)
+	}
+
+	if err := uc.userRepo.ReplaceCinemas(user, cinemas); err != nil {
+		return nil, err
+	}
+	user.Cinemas = cinemas
+	return user, nil
+}
@@ -0,0 +1,195 @@
+package usecase
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"movie-app/internal/config"
+	"movie-app/internal/domain"
+	"movie-app/internal/enums"
+	"movie-app/internal/repository"
+	"movie-app/pkg/ical"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// maxFeedEvents membatasi jumlah jadwal dalam 1 feed publik
+const maxFeedEvents = 500
+
+type CalendarFeedUseCase interface {
+	// MovieFeed, StudioFeed & CinemaFeed berisi jadwal mendatang (.ics) untuk di-subscribe partner
+	MovieFeed(movieID uuid.UUID) ([]byte, error)
+	StudioFeed(studioID uuid.UUID) ([]byte, error)
+	CinemaFeed(cinemaID uuid.UUID) ([]byte, error)
+	// TicketFeed berisi tiket paid milik pemilik token, data sama dengan GET /tickets/me
+	TicketFeed(token string) ([]byte, error)
+	// GetTicketFeedToken mengembalikan token feed user, dibuat jika belum ada
+	GetTicketFeedToken(userID uuid.UUID) (string, error)
+	// RotateTicketFeedToken mengganti token (URL feed lama tidak berlaku lagi)
+	RotateTicketFeedToken(userID uuid.UUID) (string, error)
+}
+
+type calendarFeedUseCase struct {
+	scheduleRepo repository.ScheduleRepository
+	movieRepo    repository.MovieRepository
+	studioRepo   repository.StudioRepository
+	ticketRepo   repository.TicketRepository
+	userRepo     repository.UserRepository
+	cinemaRepo   repository.CinemaRepository
+	cfg          *config.Config
+}
+
+func NewCalendarFeedUseCase(
+	scheduleRepo repository.ScheduleRepository,
+	movieRepo repository.MovieRepository,
+	studioRepo repository.StudioRepository,
+	ticketRepo repository.TicketRepository,
+	userRepo repository.UserRepository,
+	cinemaRepo repository.CinemaRepository,
+	cfg *config.Config,
+) CalendarFeedUseCase {
+	return &calendarFeedUseCase{scheduleRepo, movieRepo, studioRepo, ticketRepo, userRepo, cinemaRepo, cfg}
+}
+
+func (uc *calendarFeedUseCase) MovieFeed(movieID uuid.UUID) ([]byte, error) {
+	movie, err := uc.movieRepo.FindByID(movieID)
+	if err != nil {
+		return nil, errors.New("movie not found")
+	}
+	return uc.scheduleFeed(movie.Title, repository.ScheduleFilter{MovieID: movieID})
+}
+
+func (uc *calendarFeedUseCase) StudioFeed(studioID uuid.UUID) ([]byte, error) {
+	studio, err := uc.studioRepo.FindByID(studioID)
+	if err != nil {
+		return nil, errors.New("studio not found")
+	}
+	return uc.scheduleFeed(studio.Name, repository.ScheduleFilter{StudioID: studioID})
+}
+
+func (uc *calendarFeedUseCase) CinemaFeed(cinemaID uuid.UUID) ([]byte, error) {
+	cinema, err := uc.cinemaRepo.FindByID(cinemaID)
+	if err != nil {
+		return nil, errors.New("cinema not found")
+	}
+	return uc.scheduleFeed(cinema.Name, repository.ScheduleFilter{CinemaID: cinemaID})
+}
+
+// scheduleFeed menulis jadwal yang belum selesai. Jadwal batal tetap ikut (STATUS:CANCELLED)
+// agar kalender subscriber ikut menghapus / menandai event-nya.
+func (uc *calendarFeedUseCase) scheduleFeed(name string, filter repository.ScheduleFilter) ([]byte, error) {
+	filter.StartFrom = time.Now().Add(-24 * time.Hour)
+	schedules, _, err := uc.scheduleRepo.FindAll(filter, 1, maxFeedEvents)
+	if err != nil {
+		return nil, err
+	}
+
+	cal := ical.Calendar{ProdID: uc.prodID("Showtimes"), Name: fmt.Sprintf("%s - %s", uc.cfg.AppName, name)}
+	for i := range schedules {
+		s := &schedules[i]
+		if s.EndTime.Before(time.Now()) {
+			continue
+		}
+		cal.Events = append(cal.Events, ical.Event{
+			UID:         fmt.Sprintf("schedule-%s@%s", s.ID, strings.ToLower(uc.cfg.AppName)),
+			Summary:     s.Movie.Title,
+			Description: fmt.Sprintf("%s (%d min)\nStudio: %s\nPrice from Rp %.2f", s.Movie.Title, s.Movie.Duration, s.Studio.Name, s.Price),
+			Location:    s.Studio.Name,
+			Start:       s.StartTime,
+			End:         s.EndTime,
+			Status:      feedEventStatus(s),
+			Updated:     s.UpdatedAt,
+		})
+	}
+	return writeCalendar(cal)
+}
+
+func (uc *calendarFeedUseCase) TicketFeed(token string) ([]byte, error) {
+	user, err := uc.userRepo.FindByCalendarToken(token)
+	if err != nil {
+		return nil, errors.New("calendar feed not found")
+	}
+
+	// Sumber data sama dengan riwayat tiket user (GET /tickets/me)
+	transactions, err := uc.ticketRepo.GetByUserID(user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	cal := ical.Calendar{ProdID: uc.prodID("Tickets"), Name: fmt.Sprintf("%s - My Tickets", uc.cfg.AppName)}
+	for _, trx := range transactions {
+		if trx.Status != enums.TransactionPaid || len(trx.Tickets) == 0 {
+			continue
+		}
+
+		// 1 transaksi = 1 jadwal; jadwal diambil dari tiket (sudah mengikuti perubahan jadwal)
+		s := &trx.Tickets[0].Schedule
+		seats := make([]string, 0, len(trx.Tickets))
+		for _, t := range trx.Tickets {
+			seats = append(seats, fmt.Sprintf("%s%d", t.Seat.RowCode, t.Seat.SeatNumber))
+		}
+
+		updated := trx.UpdatedAt
+		if s.UpdatedAt.After(updated) {
+			updated = s.UpdatedAt
+		}
+		cal.Events = append(cal.Events, ical.Event{
+			UID:         fmt.Sprintf("booking-%s@%s", trx.ID, strings.ToLower(uc.cfg.AppName)),
+			Summary:     s.Movie.Title,
+			Description: fmt.Sprintf("Booking ID: %s\nStudio: %s\nSeats: %s", trx.ID, s.Studio.Name, strings.Join(seats, ", ")),
+			Location:    s.Studio.Name,
+			Start:       s.StartTime,
+			End:         s.EndTime,
+			Status:      feedEventStatus(s),
+			Updated:     updated,
+		})
+	}
+	return writeCalendar(cal)
+}
+
+func (uc *calendarFeedUseCase) GetTicketFeedToken(userID uuid.UUID) (string, error) {
+	user, err := uc.userRepo.FindByID(userID)
+	if err != nil {
+		return "", errors.New("user not found")
+	}
+	if user.CalendarToken != nil && *user.CalendarToken != "" {
+		return *user.CalendarToken, nil
+	}
+	return uc.RotateTicketFeedToken(userID)
+}
+
+func (uc *calendarFeedUseCase) RotateTicketFeedToken(userID uuid.UUID) (string, error) {
+	// Token acak (crypto/rand) karena URL feed berfungsi sebagai kredensial
+	buf := make([]byte, 24)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	token := hex.EncodeToString(buf)
+	if err := uc.userRepo.UpdateCalendarToken(userID, token); err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+func (uc *calendarFeedUseCase) prodID(feed string) string {
+	return fmt.Sprintf("-//%s//%s//EN", uc.cfg.AppName, feed)
+}
+
+func feedEventStatus(s *domain.Schedule) string {
+	if s.Status == enums.ScheduleCancelled {
+		return ical.StatusCancelled
+	}
+	return ical.StatusConfirmed
+}
+
+func writeCalendar(cal ical.Calendar) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := ical.Write(&buf, cal); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
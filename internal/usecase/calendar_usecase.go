@@ -0,0 +1,193 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"movie-app/internal/delivery/http/dto/request"
+	"movie-app/internal/delivery/http/dto/response"
+	"movie-app/internal/domain"
+	"movie-app/internal/enums"
+	"movie-app/internal/repository"
+	"movie-app/pkg/ical"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CalendarUseCase interface {
+	Create(req request.CreateCalendarDateRequest) (*domain.CalendarDate, error)
+	Update(id uuid.UUID, req request.UpdateCalendarDateRequest) (*domain.CalendarDate, error)
+	Delete(id uuid.UUID) error
+	GetAll(year int) ([]domain.CalendarDate, error)
+	// ImportICal menyimpan setiap VEVENT sebagai tanggal kalender. Import ulang file yang sama
+	// meng-update entri lama (berdasarkan UID), bukan menduplikasi.
+	ImportICal(r io.Reader, dayType string) (*response.CalendarImportResponse, error)
+}
+
+type calendarUseCase struct {
+	calendarRepo repository.CalendarRepository
+}
+
+func NewCalendarUseCase(calendarRepo repository.CalendarRepository) CalendarUseCase {
+	return &calendarUseCase{calendarRepo}
+}
+
+func (uc *calendarUseCase) Create(req request.CreateCalendarDateRequest) (*domain.CalendarDate, error) {
+	date, err := time.Parse("2006-01-02", req.Date)
+	if err != nil {
+		return nil, errors.New("date must use YYYY-MM-DD format")
+	}
+
+	calendarDate := &domain.CalendarDate{
+		Name:        req.Name,
+		Date:        date,
+		DayType:     req.DayType,
+		IsRecurring: req.IsRecurring,
+		Description: req.Description,
+		Source:      enums.CalendarSourceManual,
+	}
+	if err := uc.calendarRepo.Create(calendarDate); err != nil {
+		return nil, err
+	}
+	return calendarDate, nil
+}
+
+func (uc *calendarUseCase) Update(id uuid.UUID, req request.UpdateCalendarDateRequest) (*domain.CalendarDate, error) {
+	calendarDate, err := uc.calendarRepo.FindByID(id)
+	if err != nil {
+		return nil, errors.New("calendar date not found")
+	}
+
+	if req.Name != "" {
+		calendarDate.Name = req.Name
+	}
+	if req.Date != "" {
+		date, err := time.Parse("2006-01-02", req.Date)
+		if err != nil {
+			return nil, errors.New("date must use YYYY-MM-DD format")
+		}
+		calendarDate.Date = date
+	}
+	if req.DayType != "" {
+		calendarDate.DayType = req.DayType
+	}
+	if req.IsRecurring != nil {
+		calendarDate.IsRecurring = *req.IsRecurring
+	}
+	if req.Description != nil {
+		calendarDate.Description = *req.Description
+	}
+
+	if err := uc.calendarRepo.Update(calendarDate); err != nil {
+		return nil, err
+	}
+	return calendarDate, nil
+}
+
+func (uc *calendarUseCase) Delete(id uuid.UUID) error {
+	if _, err := uc.calendarRepo.FindByID(id); err != nil {
+		return errors.New("calendar date not found")
+	}
+	return uc.calendarRepo.Delete(id)
+}
+
+func (uc *calendarUseCase) GetAll(year int) ([]domain.CalendarDate, error) {
+	return uc.calendarRepo.FindAll(year)
+}
+
+func (uc *calendarUseCase) ImportICal(r io.Reader, dayType string) (*response.CalendarImportResponse, error) {
+	if dayType == "" {
+		dayType = enums.DayTypeHoliday
+	}
+	if dayType != enums.DayTypeHoliday && dayType != enums.DayTypeSpecialEvent {
+		return nil, errors.New("day_type must be holiday or special_event")
+	}
+
+	events, err := ical.Parse(r)
+	if err != nil {
+		return nil, fmt.Errorf("invalid iCalendar file: %w", err)
+	}
+
+	result := &response.CalendarImportResponse{}
+	for _, event := range events {
+		if event.Summary == "" {
+			result.Errors = append(result.Errors, fmt.Sprintf("event %s skipped: missing SUMMARY", event.UID))
+			continue
+		}
+
+		// Event beberapa hari disimpan per tanggal
+		for _, date := range event.Dates() {
+			var existing *domain.CalendarDate
+			if event.UID != "" {
+				existing, err = uc.calendarRepo.FindByExternalUID(event.UID, date)
+				if err != nil {
+					return nil, err
+				}
+			}
+
+			if existing != nil {
+				existing.Name = event.Summary
+				existing.Description = event.Description
+				existing.DayType = dayType
+				existing.IsRecurring = event.Yearly
+				if err := uc.calendarRepo.Update(existing); err != nil {
+					result.Errors = append(result.Errors, fmt.Sprintf("%s (%s): %v", event.Summary, date.Format("2006-01-02"), err))
+					continue
+				}
+				result.Updated++
+				continue
+			}
+
+			calendarDate := &domain.CalendarDate{
+				Name:        event.Summary,
+				Date:        date,
+				DayType:     dayType,
+				IsRecurring: event.Yearly,
+				Description: event.Description,
+				Source:      enums.CalendarSourceICal,
+				ExternalUID: event.UID,
+			}
+			if err := uc.calendarRepo.Create(calendarDate); err != nil {
+				result.Errors = append(result.Errors, fmt.Sprintf("%s (%s): %v", event.Summary, date.Format("2006-01-02"), err))
+				continue
+			}
+			result.Created++
+		}
+	}
+
+	return result, nil
+}
+
+// calendarDayTypes mengembalikan jenis hari untuk tanggal t (holiday / special_event),
+// atau "regular" jika tanggal tsb tidak ada di kalender.
+func calendarDayTypes(calendarRepo repository.CalendarRepository, t time.Time) ([]string, error) {
+	dates, err := calendarRepo.FindForDate(t)
+	if err != nil {
+		return nil, err
+	}
+
+	var dayTypes []string
+	for _, d := range dates {
+		if !containsFold(dayTypes, d.DayType) {
+			dayTypes = append(dayTypes, d.DayType)
+		}
+	}
+	if len(dayTypes) == 0 {
+		dayTypes = []string{enums.DayTypeRegular}
+	}
+	return dayTypes, nil
+}
+
+// matchesDayTypes: kondisi kosong = tidak dibatasi
+func matchesDayTypes(conditions []string, dayTypes []string) bool {
+	if len(conditions) == 0 {
+		return true
+	}
+	for _, dayType := range dayTypes {
+		if containsFold(conditions, dayType) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,258 @@
+package usecase
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/csv"
+	"errors"
+	"math"
+	"math/big"
+	"movie-app/internal/delivery/http/dto/request"
+	"movie-app/internal/delivery/http/dto/response"
+	"movie-app/internal/domain"
+	"movie-app/internal/enums"
+	"movie-app/internal/repository"
+	"movie-app/pkg/utils"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Tanpa karakter yang mirip (0/O, 1/I/L) supaya kode mudah diketik
+const voucherAlphabet = "ABCDEFGHJKMNPQRSTUVWXYZ23456789"
+
+const defaultVoucherLength = 12
+
+type CampaignUseCase interface {
+	Create(req request.CreateCampaignRequest) (*domain.Campaign, error)
+	GetAll() ([]domain.Campaign, error)
+	GetByID(id uuid.UUID) (*domain.Campaign, *response.CampaignSummaryResponse, error)
+	GenerateCodes(campaignID uuid.UUID, req request.GenerateVoucherCodesRequest) ([]string, error)
+	GetCodes(campaignID uuid.UUID, status string, page int, limit int) ([]response.VoucherCodeResponse, *utils.PaginationMeta, error)
+	ExportCodesCSV(campaignID uuid.UUID) ([]byte, error)
+	RevokeCodes(campaignID uuid.UUID, req request.RevokeVoucherCodesRequest) (int64, error)
+}
+
+type campaignUseCase struct {
+	campaignRepo repository.CampaignRepository
+	promoRepo    repository.PromoRepository
+}
+
+func NewCampaignUseCase(campaignRepo repository.CampaignRepository, promoRepo repository.PromoRepository) CampaignUseCase {
+	return &campaignUseCase{campaignRepo, promoRepo}
+}
+
+func (uc *campaignUseCase) Create(req request.CreateCampaignRequest) (*domain.Campaign, error) {
+	promoID, _ := uuid.Parse(req.PromoID)
+	if _, err := uc.promoRepo.FindByID(promoID); err != nil {
+		return nil, errors.New("promo not found")
+	}
+
+	// Satu promo hanya boleh dimiliki satu campaign
+	exists, err := uc.campaignRepo.ExistsByPromoID(promoID)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, errors.New("promo is already used by another campaign")
+	}
+
+	campaign := &domain.Campaign{
+		Name:        req.Name,
+		Partner:     req.Partner,
+		Description: req.Description,
+		PromoID:     promoID,
+	}
+	if err := uc.campaignRepo.Create(campaign); err != nil {
+		return nil, err
+	}
+	return uc.campaignRepo.FindByID(campaign.ID)
+}
+
+func (uc *campaignUseCase) GetAll() ([]domain.Campaign, error) {
+	return uc.campaignRepo.FindAll()
+}
+
+func (uc *campaignUseCase) GetByID(id uuid.UUID) (*domain.Campaign, *response.CampaignSummaryResponse, error) {
+	campaign, err := uc.campaignRepo.FindByID(id)
+	if err != nil {
+		return nil, nil, errors.New("campaign not found")
+	}
+	summary, err := uc.campaignRepo.GetCodeSummary(id)
+	if err != nil {
+		return nil, nil, err
+	}
+	return campaign, summary, nil
+}
+
+func (uc *campaignUseCase) GenerateCodes(campaignID uuid.UUID, req request.GenerateVoucherCodesRequest) ([]string, error) {
+	if _, err := uc.campaignRepo.FindByID(campaignID); err != nil {
+		return nil, errors.New("campaign not found")
+	}
+
+	length := req.Length
+	if length == 0 {
+		length = defaultVoucherLength
+	}
+	prefix := strings.ToUpper(req.Prefix)
+
+	// Kode yang bentrok dengan kode lama dibuang lalu diganti batch baru.
+	// Dengan panjang >= 8 dari 31 karakter, bentrok sangat jarang.
+	var generated []string
+	for attempt := 0; attempt < 5 && len(generated) < req.Count; attempt++ {
+		batch, err := randomVoucherCodes(req.Count-len(generated), prefix, length)
+		if err != nil {
+			return nil, err
+		}
+
+		existing, err := uc.campaignRepo.FindExistingCodes(batch)
+		if err != nil {
+			return nil, err
+		}
+		taken := make(map[string]bool, len(existing))
+		for _, code := range existing {
+			taken[code] = true
+		}
+
+		vouchers := make([]domain.VoucherCode, 0, len(batch))
+		for _, code := range batch {
+			if !taken[code] {
+				vouchers = append(vouchers, domain.VoucherCode{CampaignID: campaignID, Code: code})
+			}
+		}
+		if len(vouchers) == 0 {
+			continue
+		}
+		if err := uc.campaignRepo.CreateCodes(vouchers); err != nil {
+			return nil, err
+		}
+		for _, v := range vouchers {
+			generated = append(generated, v.Code)
+		}
+	}
+
+	if len(generated) < req.Count {
+		return generated, errors.New("could not generate enough unique codes, try a longer length")
+	}
+	return generated, nil
+}
+
+func (uc *campaignUseCase) GetCodes(campaignID uuid.UUID, status string, page int, limit int) ([]response.VoucherCodeResponse, *utils.PaginationMeta, error) {
+	if _, err := uc.campaignRepo.FindByID(campaignID); err != nil {
+		return nil, nil, errors.New("campaign not found")
+	}
+
+	codes, total, err := uc.campaignRepo.FindCodes(campaignID, status, page, limit)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := make([]response.VoucherCodeResponse, 0, len(codes))
+	for _, v := range codes {
+		result = append(result, mapVoucherCode(v))
+	}
+
+	totalPages := int(math.Ceil(float64(total) / float64(limit)))
+	meta := &utils.PaginationMeta{
+		CurrentPage: page,
+		TotalPage:   totalPages,
+		TotalItems:  total,
+		Limit:       limit,
+	}
+	return result, meta, nil
+}
+
+func (uc *campaignUseCase) ExportCodesCSV(campaignID uuid.UUID) ([]byte, error) {
+	if _, err := uc.campaignRepo.FindByID(campaignID); err != nil {
+		return nil, errors.New("campaign not found")
+	}
+
+	codes, err := uc.campaignRepo.FindAllCodes(campaignID)
+	if err != nil {
+		return nil, err
+	}
+
+	b := &bytes.Buffer{}
+	w := csv.NewWriter(b)
+
+	if err := w.Write([]string{"Code", "Status", "Redeemed By", "Redeemed At", "Transaction ID", "Revoked At"}); err != nil {
+		return nil, err
+	}
+
+	for _, v := range codes {
+		row := mapVoucherCode(v)
+		record := []string{row.Code, row.Status, row.RedeemedEmail, formatOptionalTime(row.RedeemedAt), "", formatOptionalTime(row.RevokedAt)}
+		if row.TransactionID != nil {
+			record[4] = row.TransactionID.String()
+		}
+		if err := w.Write(record); err != nil {
+			return nil, err
+		}
+	}
+
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
+
+func (uc *campaignUseCase) RevokeCodes(campaignID uuid.UUID, req request.RevokeVoucherCodesRequest) (int64, error) {
+	if _, err := uc.campaignRepo.FindByID(campaignID); err != nil {
+		return 0, errors.New("campaign not found")
+	}
+	return uc.campaignRepo.RevokeCodes(campaignID, req.Codes)
+}
+
+func mapVoucherCode(v domain.VoucherCode) response.VoucherCodeResponse {
+	res := response.VoucherCodeResponse{
+		Code:          v.Code,
+		Status:        enums.VoucherStatusUnused,
+		RedeemedBy:    v.RedeemedBy,
+		RedeemedAt:    v.RedeemedAt,
+		TransactionID: v.TransactionID,
+		RevokedAt:     v.RevokedAt,
+	}
+	if v.RevokedAt != nil {
+		res.Status = enums.VoucherStatusRevoked
+	} else if v.RedeemedAt != nil {
+		res.Status = enums.VoucherStatusRedeemed
+	}
+	if v.User != nil {
+		res.RedeemedEmail = v.User.Email
+	}
+	return res
+}
+
+func formatOptionalTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
+
+// randomVoucherCodes membuat n kode unik (dalam batch) pakai crypto/rand agar tidak bisa ditebak
+func randomVoucherCodes(n int, prefix string, length int) ([]string, error) {
+	seen := make(map[string]bool, n)
+	codes := make([]string, 0, n)
+	max := big.NewInt(int64(len(voucherAlphabet)))
+
+	for len(codes) < n {
+		var sb strings.Builder
+		sb.WriteString(prefix)
+		for i := 0; i < length; i++ {
+			idx, err := rand.Int(rand.Reader, max)
+			if err != nil {
+				return nil, err
+			}
+			sb.WriteByte(voucherAlphabet[idx.Int64()])
+		}
+		code := sb.String()
+		if !seen[code] {
+			seen[code] = true
+			codes = append(codes, code)
+		}
+	}
+	return codes, nil
+}
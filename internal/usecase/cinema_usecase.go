@@ -0,0 +1,165 @@
+package usecase
+
+import (
+	"errors"
+	"math"
+	"movie-app/internal/config"
+	"movie-app/internal/delivery/http/dto/request"
+	"movie-app/internal/domain"
+	"movie-app/internal/repository"
+	"movie-app/pkg/utils"
+
+	"github.com/google/uuid"
+)
+
+// Default pencarian cinema terdekat
+const (
+	defaultNearbyRadiusKm = 25
+	defaultNearbyLimit    = 20
+)
+
+type CinemaUseCase interface {
+	Create(req request.CreateCinemaRequest) (*domain.Cinema, error)
+	Update(id uuid.UUID, req request.UpdateCinemaRequest) (*domain.Cinema, error)
+	// Delete ditolak jika cinema masih punya studio
+	Delete(id uuid.UUID) error
+	GetByID(id uuid.UUID) (*domain.Cinema, error)
+	// GetAll untuk publik hanya menampilkan cinema aktif (activeOnly)
+	GetAll(city string, activeOnly bool, page int, limit int) ([]domain.Cinema, *utils.PaginationMeta, error)
+	// Nearby mencari cinema aktif terdekat dari lat/lng (jarak dihitung di database)
+	Nearby(req request.NearbyCinemaRequest) ([]domain.Cinema, error)
+}
+
+type cinemaUseCase struct {
+	cinemaRepo repository.CinemaRepository
+	cfg        *config.Config
+}
+
+func NewCinemaUseCase(cinemaRepo repository.CinemaRepository, cfg *config.Config) CinemaUseCase {
+	return &cinemaUseCase{cinemaRepo, cfg}
+}
+
+func (uc *cinemaUseCase) Create(req request.CreateCinemaRequest) (*domain.Cinema, error) {
+	cinema := &domain.Cinema{
+		Name:        req.Name,
+		Address:     req.Address,
+		City:        req.City,
+		Latitude:    req.Latitude,
+		Longitude:   req.Longitude,
+		Timezone:    defaultString(req.Timezone, uc.cfg.DefaultTimezone),
+		OpeningTime: defaultString(req.OpeningTime, "10:00"),
+		ClosingTime: defaultString(req.ClosingTime, "00:00"),
+		Phone:       req.Phone,
+		Email:       req.Email,
+		IsActive:    true,
+	}
+	if cinema.OpeningTime == cinema.ClosingTime {
+		return nil, errors.New("opening_time and closing_time must be different")
+	}
+
+	if err := uc.cinemaRepo.Create(cinema); err != nil {
+		return nil, err
+	}
+	return cinema, nil
+}
+
+func (uc *cinemaUseCase) Update(id uuid.UUID, req request.UpdateCinemaRequest) (*domain.Cinema, error) {
+	cinema, err := uc.cinemaRepo.FindByID(id)
+	if err != nil {
+		return nil, errors.New("cinema not found")
+	}
+
+	if req.Name != "" {
+		cinema.Name = req.Name
+	}
+	if req.Address != "" {
+		cinema.Address = req.Address
+	}
+	if req.City != "" {
+		cinema.City = req.City
+	}
+	if req.Latitude != nil {
+		cinema.Latitude = *req.Latitude
+	}
+	if req.Longitude != nil {
+		cinema.Longitude = *req.Longitude
+	}
+	// Zona waktu studio yang sudah ada tidak ikut diubah (diatur per studio)
+	if req.Timezone != "" {
+		cinema.Timezone = req.Timezone
+	}
+	if req.OpeningTime != "" {
+		cinema.OpeningTime = req.OpeningTime
+	}
+	if req.ClosingTime != "" {
+		cinema.ClosingTime = req.ClosingTime
+	}
+	if cinema.OpeningTime == cinema.ClosingTime {
+		return nil, errors.New("opening_time and closing_time must be different")
+	}
+	if req.Phone != nil {
+		cinema.Phone = *req.Phone
+	}
+	if req.Email != nil {
+		cinema.Email = *req.Email
+	}
+	if req.IsActive != nil {
+		cinema.IsActive = *req.IsActive
+	}
+
+	if err := uc.cinemaRepo.Update(cinema); err != nil {
+		return nil, err
+	}
+	return cinema, nil
+}
+
+func (uc *cinemaUseCase) Delete(id uuid.UUID) error {
+	if _, err := uc.cinemaRepo.FindByID(id); err != nil {
+		return errors.New("cinema not found")
+	}
+
+	studios, err := uc.cinemaRepo.CountStudios(id)
+	if err != nil {
+		return err
+	}
+	if studios > 0 {
+		return errors.New("cinema still has studios, move or delete them first")
+	}
+	return uc.cinemaRepo.Delete(id)
+}
+
+func (uc *cinemaUseCase) GetByID(id uuid.UUID) (*domain.Cinema, error) {
+	cinema, err := uc.cinemaRepo.FindByID(id)
+	if err != nil {
+		return nil, errors.New("cinema not found")
+	}
+	return cinema, nil
+}
+
+func (uc *cinemaUseCase) GetAll(city string, activeOnly bool, page int, limit int) ([]domain.Cinema, *utils.PaginationMeta, error) {
+	cinemas, total, err := uc.cinemaRepo.FindAll(city, activeOnly, page, limit)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	totalPages := int(math.Ceil(float64(total) / float64(limit)))
+	meta := &utils.PaginationMeta{
+		CurrentPage: page,
+		TotalPage:   totalPages,
+		TotalItems:  total,
+		Limit:       limit,
+	}
+	return cinemas, meta, nil
+}
+
+func (uc *cinemaUseCase) Nearby(req request.NearbyCinemaRequest) ([]domain.Cinema, error) {
+	radius := req.RadiusKm
+	if radius == 0 {
+		radius = defaultNearbyRadiusKm
+	}
+	limit := req.Limit
+	if limit == 0 {
+		limit = defaultNearbyLimit
+	}
+	return uc.cinemaRepo.FindNearby(req.Lat, req.Lng, radius, limit)
+}
@@ -2,11 +2,15 @@ package usecase
 
 import (
 	"errors"
+	"fmt"
 	"math"
 	"movie-app/internal/delivery/http/dto/request"
 	"movie-app/internal/domain"
+	"movie-app/internal/enums"
 	"movie-app/internal/repository"
 	"movie-app/pkg/utils"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -16,7 +20,8 @@ type MovieUseCase interface {
 	Update(id uuid.UUID, req request.UpdateMovieRequest) (*domain.Movie, error)
 	Delete(id uuid.UUID) error
 	GetByID(id uuid.UUID) (*domain.Movie, error)
-	GetAll(page int, limit int, search string) ([]domain.Movie, *utils.PaginationMeta, error)
+	// GetAll mendukung pencarian judul/genre & filter klasifikasi usia, bahasa, dan rentang tanggal rilis
+	GetAll(req request.MovieFilterRequest, page int, limit int) ([]domain.Movie, *utils.PaginationMeta, error)
 }
 
 type movieUseCase struct {
@@ -34,7 +39,19 @@ func (uc *movieUseCase) Create(req request.CreateMovieRequest) (*domain.Movie, e
 		Duration:    req.Duration,
 		Genre:       req.Genre,
 		PosterURL:   req.PosterURL,
+
+		AgeRating:         req.AgeRating,
+		Director:          req.Director,
+		Cast:              trimList(req.Cast),
+		OriginalLanguage:  req.OriginalLanguage,
+		SubtitleLanguages: trimList(req.SubtitleLanguages),
+		TrailerURL:        req.TrailerURL,
+		BackdropURL:       req.BackdropURL,
+	}
+	if err := applyMovieRunDates(movie, req.ReleaseDate, req.EndOfRunDate); err != nil {
+		return nil, err
 	}
+
 	if err := uc.movieRepo.Create(movie); err != nil {
 		return nil, err
 	}
@@ -62,6 +79,30 @@ func (uc *movieUseCase) Update(id uuid.UUID, req request.UpdateMovieRequest) (*d
 	if req.PosterURL != "" {
 		movie.PosterURL = req.PosterURL
 	}
+	if req.AgeRating != "" {
+		movie.AgeRating = req.AgeRating
+	}
+	if req.Director != "" {
+		movie.Director = req.Director
+	}
+	if req.Cast != nil {
+		movie.Cast = trimList(req.Cast)
+	}
+	if req.OriginalLanguage != "" {
+		movie.OriginalLanguage = req.OriginalLanguage
+	}
+	if req.SubtitleLanguages != nil {
+		movie.SubtitleLanguages = trimList(req.SubtitleLanguages)
+	}
+	if req.TrailerURL != "" {
+		movie.TrailerURL = req.TrailerURL
+	}
+	if req.BackdropURL != "" {
+		movie.BackdropURL = req.BackdropURL
+	}
+	if err := applyMovieRunDates(movie, req.ReleaseDate, req.EndOfRunDate); err != nil {
+		return nil, err
+	}
 
 	if err := uc.movieRepo.Update(movie); err != nil {
 		return nil, err
@@ -81,8 +122,13 @@ func (uc *movieUseCase) GetByID(id uuid.UUID) (*domain.Movie, error) {
 	return uc.movieRepo.FindByID(id)
 }
 
-func (uc *movieUseCase) GetAll(page int, limit int, search string) ([]domain.Movie, *utils.PaginationMeta, error) {
-	movies, total, err := uc.movieRepo.FindAll(page, limit, search)
+func (uc *movieUseCase) GetAll(req request.MovieFilterRequest, page int, limit int) ([]domain.Movie, *utils.PaginationMeta, error) {
+	filter, err := buildMovieFilter(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	movies, total, err := uc.movieRepo.FindAll(filter, page, limit)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -96,3 +142,79 @@ func (uc *movieUseCase) GetAll(page int, limit int, search string) ([]domain.Mov
 	}
 	return movies, meta, nil
 }
+
+// applyMovieRunDates mengisi tanggal rilis & akhir tayang (string kosong = tidak diubah)
+func applyMovieRunDates(movie *domain.Movie, releaseDate, endOfRunDate string) error {
+	if releaseDate != "" {
+		t, err := time.Parse("2006-01-02", releaseDate)
+		if err != nil {
+			return errors.New("release_date must use YYYY-MM-DD format")
+		}
+		movie.ReleaseDate = &t
+	}
+	if endOfRunDate != "" {
+		t, err := time.Parse("2006-01-02", endOfRunDate)
+		if err != nil {
+			return errors.New("end_of_run_date must use YYYY-MM-DD format")
+		}
+		movie.EndOfRunDate = &t
+	}
+	if movie.ReleaseDate != nil && movie.EndOfRunDate != nil && movie.EndOfRunDate.Before(*movie.ReleaseDate) {
+		return errors.New("end_of_run_date must be on or after release_date")
+	}
+	return nil
+}
+
+func buildMovieFilter(req request.MovieFilterRequest) (repository.MovieFilter, error) {
+	filter := repository.MovieFilter{
+		Search:           strings.TrimSpace(req.Search),
+		Language:         strings.TrimSpace(req.Language),
+		SubtitleLanguage: strings.TrimSpace(req.SubtitleLanguage),
+	}
+
+	validRatings := []string{enums.AgeRatingSU, enums.AgeRating13, enums.AgeRating17, enums.AgeRating21}
+	for _, rating := range strings.Split(req.AgeRating, ",") {
+		// "+" di query string terbaca sebagai spasi, jadi "13 " / "13" diterima sebagai "13+"
+		rating = strings.ToUpper(strings.TrimSpace(rating))
+		if rating == "" {
+			continue
+		}
+		if rating != enums.AgeRatingSU && !strings.HasSuffix(rating, "+") {
+			rating += "+"
+		}
+		if !containsFold(validRatings, rating) {
+			return filter, fmt.Errorf("age_rating must be one of %s", strings.Join(validRatings, ", "))
+		}
+		filter.AgeRatings = append(filter.AgeRatings, rating)
+	}
+
+	if req.ReleasedFrom != "" {
+		from, err := time.Parse("2006-01-02", req.ReleasedFrom)
+		if err != nil {
+			return filter, errors.New("released_from must use YYYY-MM-DD format")
+		}
+		filter.ReleasedFrom = from
+	}
+	if req.ReleasedTo != "" {
+		to, err := time.Parse("2006-01-02", req.ReleasedTo)
+		if err != nil {
+			return filter, errors.New("released_to must use YYYY-MM-DD format")
+		}
+		filter.ReleasedTo = to
+	}
+	if !filter.ReleasedFrom.IsZero() && !filter.ReleasedTo.IsZero() && filter.ReleasedTo.Before(filter.ReleasedFrom) {
+		return filter, errors.New("released_to must be on or after released_from")
+	}
+	return filter, nil
+}
+
+// trimList membuang spasi & item kosong. Selalu non-nil agar tersimpan sebagai '{}'.
+func trimList(items []string) []string {
+	result := []string{}
+	for _, item := range items {
+		if item = strings.TrimSpace(item); item != "" {
+			result = append(result, item)
+		}
+	}
+	return result
+}
@@ -0,0 +1,238 @@
+package usecase
+
+import (
+	"errors"
+	"movie-app/internal/delivery/http/dto/request"
+	"movie-app/internal/delivery/http/dto/response"
+	"movie-app/internal/domain"
+	"movie-app/internal/enums"
+	"movie-app/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+type PaymentMethodUseCase interface {
+	Create(req request.CreatePaymentMethodRequest) (*domain.PaymentMethod, error)
+	Update(id uuid.UUID, req request.UpdatePaymentMethodRequest) (*domain.PaymentMethod, error)
+	Delete(id uuid.UUID) error
+	GetAll() ([]domain.PaymentMethod, error)
+	// GetAvailableForTransaction menampilkan metode yang valid untuk transaksi milik user
+	GetAvailableForTransaction(userID uuid.UUID, transactionID uuid.UUID) ([]response.PaymentMethodResponse, error)
+}
+
+type paymentMethodUseCase struct {
+	methodRepo repository.PaymentMethodRepository
+	transRepo  repository.TransactionRepository
+	cinemaRepo repository.CinemaRepository
+}
+
+func NewPaymentMethodUseCase(
+	methodRepo repository.PaymentMethodRepository,
+	transRepo repository.TransactionRepository,
+	cinemaRepo repository.CinemaRepository,
+) PaymentMethodUseCase {
+	return &paymentMethodUseCase{methodRepo, transRepo, cinemaRepo}
+}
+
+func (uc *paymentMethodUseCase) Create(req request.CreatePaymentMethodRequest) (*domain.PaymentMethod, error) {
+	if req.MaxAmount > 0 && req.MaxAmount < req.MinAmount {
+		return nil, errors.New("max_amount must be greater than min_amount")
+	}
+
+	cinemaIDs, err := uc.parseCinemaIDs(req.CinemaIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	method := &domain.PaymentMethod{
+		Code:          req.Code,
+		DisplayName:   req.DisplayName,
+		IconURL:       req.IconURL,
+		IsEnabled:     true,
+		MinAmount:     req.MinAmount,
+		MaxAmount:     req.MaxAmount,
+		FeeType:       req.FeeType,
+		FeeValue:      req.FeeValue,
+		ExpiryMinutes: req.ExpiryMinutes,
+	}
+	if req.IsEnabled != nil {
+		method.IsEnabled = *req.IsEnabled
+	}
+	if method.FeeType == "" {
+		method.FeeType = enums.FeeTypeFixed
+	}
+
+	// Hanya metode aktif yang dicek: kode milik metode yang sudah dihapus boleh dipakai lagi
+	// (unique index idx_payment_methods_code_active mengabaikan baris soft delete)
+	if _, err := uc.methodRepo.FindByCode(req.Code); err == nil {
+		return nil, errors.New("payment method code already exists")
+	}
+
+	if err := uc.methodRepo.Create(method); err != nil {
+		return nil, err
+	}
+	if len(cinemaIDs) > 0 {
+		if err := uc.methodRepo.ReplaceCinemas(method, cinemaIDs); err != nil {
+			return nil, err
+		}
+	}
+	return uc.methodRepo.FindByID(method.ID)
+}
+
+func (uc *paymentMethodUseCase) Update(id uuid.UUID, req request.UpdatePaymentMethodRequest) (*domain.PaymentMethod, error) {
+	method, err := uc.methodRepo.FindByID(id)
+	if err != nil {
+		return nil, errors.New("payment method not found")
+	}
+
+	if req.DisplayName != "" {
+		method.DisplayName = req.DisplayName
+	}
+	if req.IconURL != "" {
+		method.IconURL = req.IconURL
+	}
+	if req.IsEnabled != nil {
+		method.IsEnabled = *req.IsEnabled
+	}
+	if req.MinAmount != nil {
+		method.MinAmount = *req.MinAmount
+	}
+	if req.MaxAmount != nil {
+		method.MaxAmount = *req.MaxAmount
+	}
+	if req.FeeType != "" {
+		method.FeeType = req.FeeType
+	}
+	if req.FeeValue != nil {
+		method.FeeValue = *req.FeeValue
+	}
+	if req.ExpiryMinutes != nil {
+		method.ExpiryMinutes = *req.ExpiryMinutes
+	}
+
+	if method.MaxAmount > 0 && method.MaxAmount < method.MinAmount {
+		return nil, errors.New("max_amount must be greater than min_amount")
+	}
+
+	if err := uc.methodRepo.Update(method); err != nil {
+		return nil, err
+	}
+
+	// nil = tidak diubah, slice kosong = tersedia di semua cinema
+	if req.CinemaIDs != nil {
+		cinemaIDs, err := uc.parseCinemaIDs(req.CinemaIDs)
+		if err != nil {
+			return nil, err
+		}
+		if err := uc.methodRepo.ReplaceCinemas(method, cinemaIDs); err != nil {
+			return nil, err
+		}
+	}
+
+	return uc.methodRepo.FindByID(id)
+}
+
+func (uc *paymentMethodUseCase) Delete(id uuid.UUID) error {
+	if _, err := uc.methodRepo.FindByID(id); err != nil {
+		return errors.New("payment method not found")
+	}
+	return uc.methodRepo.Delete(id)
+}
+
+func (uc *paymentMethodUseCase) GetAll() ([]domain.PaymentMethod, error) {
+	return uc.methodRepo.FindAll()
+}
+
+func (uc *paymentMethodUseCase) GetAvailableForTransaction(userID uuid.UUID, transactionID uuid.UUID) ([]response.PaymentMethodResponse, error) {
+	transaction, err := uc.transRepo.FindByID(transactionID)
+	if err != nil {
+		return nil, errors.New("transaction not found")
+	}
+	if transaction.UserID != userID {
+		return nil, errors.New("unauthorized access to this transaction")
+	}
+	if transaction.Status != enums.TransactionPending {
+		return nil, errors.New("transaction is not pending (already paid or cancelled)")
+	}
+
+	methods, err := uc.methodRepo.FindEnabled()
+	if err != nil {
+		return nil, err
+	}
+
+	// Biaya dihitung dari harga sebelum fee (fee lama dari pre-select metode dikeluarkan dulu)
+	amount := transaction.FinalAmount - transaction.PaymentFee
+	cinemaID := transactionCinemaID(transaction)
+
+	result := []response.PaymentMethodResponse{}
+	for _, m := range methods {
+		if err := checkPaymentMethod(&m, amount, cinemaID); err != nil {
+			continue
+		}
+		fee := calculatePaymentFee(&m, amount)
+		result = append(result, response.PaymentMethodResponse{
+			ID:          m.ID,
+			Code:        m.Code,
+			DisplayName: m.DisplayName,
+			IconURL:     m.IconURL,
+			Fee:         fee,
+			TotalAmount: amount + fee,
+		})
+	}
+	return result, nil
+}
+
+func (uc *paymentMethodUseCase) parseCinemaIDs(raw []string) ([]uuid.UUID, error) {
+	ids := make([]uuid.UUID, 0, len(raw))
+	for _, s := range raw {
+		id, _ := uuid.Parse(s)
+		if _, err := uc.cinemaRepo.FindByID(id); err != nil {
+			return nil, errors.New("cinema not found")
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// checkPaymentMethod memastikan metode bisa dipakai untuk nominal & lokasi tertentu.
+// Dipakai bersama oleh booking, pembayaran, dan listing metode agar aturannya tidak drift.
+// cinemaID nil (studio belum di-assign ke cinema) hanya cocok dengan metode tanpa batasan lokasi.
+func checkPaymentMethod(method *domain.PaymentMethod, amount float64, cinemaID *uuid.UUID) error {
+	if !method.IsEnabled {
+		return errors.New("payment method is disabled")
+	}
+	if amount < method.MinAmount {
+		return errors.New("amount is below the minimum for this payment method")
+	}
+	if method.MaxAmount > 0 && amount > method.MaxAmount {
+		return errors.New("amount exceeds the maximum for this payment method")
+	}
+	if len(method.Cinemas) > 0 {
+		available := false
+		for _, c := range method.Cinemas {
+			if cinemaID != nil && c.ID == *cinemaID {
+				available = true
+				break
+			}
+		}
+		if !available {
+			return errors.New("payment method is not available at this location")
+		}
+	}
+	return nil
+}
+
+func calculatePaymentFee(method *domain.PaymentMethod, amount float64) float64 {
+	if method.FeeType == enums.FeeTypePercentage {
+		return amount * (method.FeeValue / 100)
+	}
+	return method.FeeValue
+}
+
+// transactionCinemaID mengambil cinema dari studio tiket pertama (1 transaksi = 1 jadwal)
+func transactionCinemaID(transaction *domain.Transaction) *uuid.UUID {
+	if len(transaction.Tickets) == 0 {
+		return nil
+	}
+	return transaction.Tickets[0].Schedule.Studio.CinemaID
+}
@@ -0,0 +1,425 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"movie-app/internal/delivery/http/dto/request"
+	"movie-app/internal/delivery/http/dto/response"
+	"movie-app/internal/domain"
+	"movie-app/internal/enums"
+	"movie-app/internal/repository"
+	"movie-app/pkg/logger"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type PricingRuleUseCase interface {
+	Create(req request.CreatePricingRuleRequest) (*domain.PricingRule, error)
+	Update(id uuid.UUID, req request.UpdatePricingRuleRequest) (*domain.PricingRule, error)
+	Delete(id uuid.UUID) error
+	GetAll() ([]domain.PricingRule, error)
+	// PreviewSchedule menampilkan harga per kategori kursi & rule yang berlaku untuk sebuah jadwal
+	PreviewSchedule(scheduleID uuid.UUID) (*response.PricingPreviewResponse, error)
+	// RecordUpcomingPrices dijalankan worker berkala: menghitung ulang harga efektif jadwal mendatang
+	// & mencatat riwayat jika berubah karena rule waktu / okupansi, walau tidak ada yang membuka jadwal.
+	RecordUpcomingPrices(now time.Time) error
+}
+
+// Jadwal aktif yang dipantau RecordUpcomingPrices (per batch query)
+const (
+	priceSnapshotHorizon = 7 * 24 * time.Hour
+	priceSnapshotBatch   = 200
+)
+
+type pricingRuleUseCase struct {
+	ruleRepo     repository.PricingRuleRepository
+	scheduleRepo repository.ScheduleRepository
+	ticketRepo   repository.TicketRepository
+	calendarRepo repository.CalendarRepository
+}
+
+func NewPricingRuleUseCase(
+	ruleRepo repository.PricingRuleRepository,
+	scheduleRepo repository.ScheduleRepository,
+	ticketRepo repository.TicketRepository,
+	calendarRepo repository.CalendarRepository,
+) PricingRuleUseCase {
+	return &pricingRuleUseCase{ruleRepo, scheduleRepo, ticketRepo, calendarRepo}
+}
+
+func (uc *pricingRuleUseCase) Create(req request.CreatePricingRuleRequest) (*domain.PricingRule, error) {
+	rule := &domain.PricingRule{
+		Name:            req.Name,
+		Description:     req.Description,
+		Priority:        req.Priority,
+		IsActive:        true,
+		Weekdays:        req.Weekdays,
+		StartTime:       req.StartTime,
+		EndTime:         req.EndTime,
+		MovieIDs:        req.MovieIDs,
+		SeatCategories:  req.SeatCategories,
+		DayTypes:        req.DayTypes,
+		Formats:         req.Formats,
+		Features:        req.Features,
+		AudioLanguages:  req.AudioLanguages,
+		MinOccupancy:    req.MinOccupancy,
+		MaxOccupancy:    req.MaxOccupancy,
+		WithinHours:     req.WithinHours,
+		AdjustmentType:  req.AdjustmentType,
+		AdjustmentValue: req.AdjustmentValue,
+		StopProcessing:  req.StopProcessing,
+	}
+	if req.IsActive != nil {
+		rule.IsActive = *req.IsActive
+	}
+
+	if err := validatePricingRule(rule); err != nil {
+		return nil, err
+	}
+
+	if err := uc.ruleRepo.Create(rule); err != nil {
+		return nil, err
+	}
+	return rule, nil
+}
+
+func (uc *pricingRuleUseCase) Update(id uuid.UUID, req request.UpdatePricingRuleRequest) (*domain.PricingRule, error) {
+	rule, err := uc.ruleRepo.FindByID(id)
+	if err != nil {
+		return nil, errors.New("pricing rule not found")
+	}
+
+	if req.Name != "" {
+		rule.Name = req.Name
+	}
+	if req.Description != nil {
+		rule.Description = *req.Description
+	}
+	if req.Priority != nil {
+		rule.Priority = *req.Priority
+	}
+	if req.IsActive != nil {
+		rule.IsActive = *req.IsActive
+	}
+	if req.Weekdays != nil {
+		rule.Weekdays = req.Weekdays
+	}
+	if req.StartTime != nil {
+		rule.StartTime = *req.StartTime
+	}
+	if req.EndTime != nil {
+		rule.EndTime = *req.EndTime
+	}
+	if req.MovieIDs != nil {
+		rule.MovieIDs = req.MovieIDs
+	}
+	if req.SeatCategories != nil {
+		rule.SeatCategories = req.SeatCategories
+	}
+	if req.DayTypes != nil {
+		rule.DayTypes = req.DayTypes
+	}
+	if req.Formats != nil {
+		rule.Formats = req.Formats
+	}
+	if req.Features != nil {
+		rule.Features = req.Features
+	}
+	if req.AudioLanguages != nil {
+		rule.AudioLanguages = req.AudioLanguages
+	}
+	if req.MinOccupancy != nil {
+		rule.MinOccupancy = *req.MinOccupancy
+	}
+	if req.MaxOccupancy != nil {
+		rule.MaxOccupancy = *req.MaxOccupancy
+	}
+	if req.WithinHours != nil {
+		rule.WithinHours = *req.WithinHours
+	}
+	if req.AdjustmentType != "" {
+		rule.AdjustmentType = req.AdjustmentType
+	}
+	if req.AdjustmentValue != nil {
+		rule.AdjustmentValue = *req.AdjustmentValue
+	}
+	if req.StopProcessing != nil {
+		rule.StopProcessing = *req.StopProcessing
+	}
+
+	if err := validatePricingRule(rule); err != nil {
+		return nil, err
+	}
+
+	if err := uc.ruleRepo.Update(rule); err != nil {
+		return nil, err
+	}
+	return rule, nil
+}
+
+func (uc *pricingRuleUseCase) Delete(id uuid.UUID) error {
+	if _, err := uc.ruleRepo.FindByID(id); err != nil {
+		return errors.New("pricing rule not found")
+	}
+	return uc.ruleRepo.Delete(id)
+}
+
+func (uc *pricingRuleUseCase) GetAll() ([]domain.PricingRule, error) {
+	return uc.ruleRepo.FindAll()
+}
+
+func (uc *pricingRuleUseCase) PreviewSchedule(scheduleID uuid.UUID) (*response.PricingPreviewResponse, error) {
+	schedule, err := uc.scheduleRepo.FindByID(scheduleID)
+	if err != nil {
+		return nil, errors.New("schedule not found")
+	}
+
+	rules, err := uc.ruleRepo.FindActive()
+	if err != nil {
+		return nil, err
+	}
+	occupancy, err := scheduleOccupancy(uc.ticketRepo, schedule)
+	if err != nil {
+		return nil, err
+	}
+	dayTypes, err := calendarDayTypes(uc.calendarRepo, scheduleLocalStart(schedule))
+	if err != nil {
+		return nil, err
+	}
+	pricingCtx := pricingContext{Occupancy: occupancy, Now: time.Now(), DayTypes: dayTypes}
+
+	preview := &response.PricingPreviewResponse{
+		ScheduleID: schedule.ID,
+		BasePrice:  schedule.Price,
+		Legacy:     schedule.LegacyPricing,
+		MinPrice:   schedule.MinPrice,
+		MaxPrice:   schedule.MaxPrice,
+		Occupancy:  occupancy,
+		DayTypes:   dayTypes,
+	}
+	for _, category := range []string{enums.SeatCategoryRegular, enums.SeatCategoryPremium, enums.SeatCategoryCouple} {
+		price, applied := calculateSeatPrice(rules, schedule, category, pricingCtx)
+		preview.Categories = append(preview.Categories, response.PricingPreviewCategoryResponse{
+			SeatCategory: category,
+			Price:        price,
+			AppliedRules: applied,
+		})
+	}
+	return preview, nil
+}
+
+func (uc *pricingRuleUseCase) RecordUpcomingPrices(now time.Time) error {
+	rules, err := uc.ruleRepo.FindActive()
+	if err != nil {
+		return err
+	}
+
+	filter := repository.ScheduleFilter{StartFrom: now, StartTo: now.Add(priceSnapshotHorizon), Status: enums.ScheduleActive}
+	var errs []error
+	for page := 1; ; page++ {
+		schedules, _, err := uc.scheduleRepo.FindAll(filter, page, priceSnapshotBatch)
+		if err != nil {
+			return err
+		}
+		// Satu jadwal gagal tidak menghentikan jadwal lain
+		for i := range schedules {
+			if err := uc.recordEffectivePrices(rules, &schedules[i], now); err != nil {
+				errs = append(errs, fmt.Errorf("schedule %s: %w", schedules[i].ID, err))
+			}
+		}
+		if len(schedules) < priceSnapshotBatch {
+			return errors.Join(errs...)
+		}
+	}
+}
+
+func (uc *pricingRuleUseCase) recordEffectivePrices(rules []domain.PricingRule, schedule *domain.Schedule, now time.Time) error {
+	occupancy, err := scheduleOccupancy(uc.ticketRepo, schedule)
+	if err != nil {
+		return err
+	}
+	dayTypes, err := calendarDayTypes(uc.calendarRepo, scheduleLocalStart(schedule))
+	if err != nil {
+		return err
+	}
+	pricingCtx := pricingContext{Occupancy: occupancy, Now: now, DayTypes: dayTypes}
+
+	for _, category := range []string{enums.SeatCategoryRegular, enums.SeatCategoryPremium, enums.SeatCategoryCouple} {
+		price, _ := calculateSeatPrice(rules, schedule, category, pricingCtx)
+		if err := recordSchedulePrice(uc.scheduleRepo, schedule.ID, category, price, enums.PriceChangeDynamic, occupancy); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validatePricingRule dipakai saat create & update (setelah field digabung)
+func validatePricingRule(rule *domain.PricingRule) error {
+	if err := validatePromoTimeWindow(rule.StartTime, rule.EndTime); err != nil {
+		return err
+	}
+	if rule.AdjustmentType == enums.AdjustmentTypePercentage && rule.AdjustmentValue <= -100 {
+		return errors.New("percentage adjustment must be greater than -100")
+	}
+	if rule.MaxOccupancy > 0 && rule.MaxOccupancy <= rule.MinOccupancy {
+		return errors.New("max_occupancy must be greater than min_occupancy")
+	}
+	return nil
+}
+
+// pricingContext berisi kondisi demand saat harga dihitung
+type pricingContext struct {
+	Occupancy float64 // Persen kursi terjual (0-100)
+	Now       time.Time
+	DayTypes  []string // Jenis hari jadwal dari kalender
+}
+
+// scheduleOccupancy menghitung persentase kursi yang sudah laku (pending + paid)
+func scheduleOccupancy(ticketRepo repository.TicketRepository, schedule *domain.Schedule) (float64, error) {
+	if schedule.Studio.Capacity <= 0 {
+		return 0, nil
+	}
+	booked, err := ticketRepo.GetBookedSeats(schedule.ID)
+	if err != nil {
+		return 0, err
+	}
+	occupancy := float64(len(booked)) / float64(schedule.Studio.Capacity) * 100
+	return math.Min(math.Round(occupancy*100)/100, 100), nil
+}
+
+// calculateSeatPrice menghitung harga satu kursi dari harga dasar jadwal.
+// rules harus sudah urut sesuai prioritas (lihat PricingRuleRepository.FindActive).
+// Penyesuaian persentase dihitung dari harga berjalan, hasil akhir dibatasi min_price / max_price jadwal
+// dan tidak pernah di bawah 0.
+func calculateSeatPrice(rules []domain.PricingRule, schedule *domain.Schedule, seatCategory string, pricingCtx pricingContext) (float64, []response.AppliedPricingRuleResponse) {
+	price := schedule.Price
+	applied := []response.AppliedPricingRuleResponse{}
+	if schedule.LegacyPricing {
+		rules = nil // Harga lama sudah final, lihat domain.Schedule.LegacyPricing
+	}
+
+	for i := range rules {
+		rule := &rules[i]
+		if !pricingRuleMatches(rule, schedule, seatCategory, pricingCtx) {
+			continue
+		}
+
+		var amount float64
+		if rule.AdjustmentType == enums.AdjustmentTypePercentage {
+			amount = math.Round(price * rule.AdjustmentValue / 100)
+		} else {
+			amount = rule.AdjustmentValue
+		}
+		if price+amount < 0 {
+			amount = -price
+		}
+		price += amount
+
+		applied = append(applied, response.AppliedPricingRuleResponse{
+			RuleID:          rule.ID,
+			Name:            rule.Name,
+			AdjustmentType:  rule.AdjustmentType,
+			AdjustmentValue: rule.AdjustmentValue,
+			Amount:          amount,
+		})
+
+		if rule.StopProcessing {
+			break
+		}
+	}
+
+	if schedule.MinPrice > 0 && price < schedule.MinPrice {
+		price = schedule.MinPrice
+	}
+	if schedule.MaxPrice > 0 && price > schedule.MaxPrice {
+		price = schedule.MaxPrice
+	}
+
+	return price, applied
+}
+
+func pricingRuleMatches(rule *domain.PricingRule, schedule *domain.Schedule, seatCategory string, pricingCtx pricingContext) bool {
+	// Hari & jam dievaluasi di jam lokal studio
+	localStart := scheduleLocalStart(schedule)
+	if len(rule.Weekdays) > 0 && !containsFold(rule.Weekdays, localStart.Weekday().String()) {
+		return false
+	}
+	if rule.StartTime != "" && rule.EndTime != "" &&
+		!isWithinTimeWindow(localStart.Format("15:04"), rule.StartTime, rule.EndTime) {
+		return false
+	}
+	if len(rule.MovieIDs) > 0 && !containsFold(rule.MovieIDs, schedule.MovieID.String()) {
+		return false
+	}
+	if len(rule.SeatCategories) > 0 && !containsFold(rule.SeatCategories, seatCategory) {
+		return false
+	}
+	if !matchesDayTypes(rule.DayTypes, pricingCtx.DayTypes) {
+		return false
+	}
+	// Atribut tayang: format & bahasa harus cocok, fitur cukup salah satu (mis. imax atau 4dx)
+	if len(rule.Formats) > 0 && !containsFold(rule.Formats, defaultString(schedule.Format, enums.Format2D)) {
+		return false
+	}
+	if len(rule.AudioLanguages) > 0 && !containsFold(rule.AudioLanguages, schedule.AudioLanguage) {
+		return false
+	}
+	if len(rule.Features) > 0 && !containsAnyFold(rule.Features, schedule.Features) {
+		return false
+	}
+	if rule.MinOccupancy > 0 && pricingCtx.Occupancy < rule.MinOccupancy {
+		return false
+	}
+	if rule.MaxOccupancy > 0 && pricingCtx.Occupancy >= rule.MaxOccupancy {
+		return false
+	}
+	if rule.WithinHours > 0 && schedule.StartTime.Sub(pricingCtx.Now) > time.Duration(rule.WithinHours)*time.Hour {
+		return false
+	}
+	return true
+}
+
+// recordSchedulePrice mencatat harga baru ke riwayat jika berbeda dari catatan terakhir kategori tsb
+func recordSchedulePrice(scheduleRepo repository.ScheduleRepository, scheduleID uuid.UUID, seatCategory string, price float64, reason string, occupancy float64) error {
+	latest, err := scheduleRepo.FindLatestPrice(scheduleID, seatCategory)
+	if err != nil {
+		return err
+	}
+	oldPrice := 0.0
+	if latest != nil {
+		if latest.NewPrice == price {
+			return nil
+		}
+		oldPrice = latest.NewPrice
+	}
+
+	return scheduleRepo.CreatePriceHistory(&domain.SchedulePriceHistory{
+		ScheduleID:   scheduleID,
+		SeatCategory: seatCategory,
+		OldPrice:     oldPrice,
+		NewPrice:     price,
+		Reason:       reason,
+		Occupancy:    occupancy,
+	})
+}
+
+// logPriceHistoryError: riwayat harga tidak boleh menggagalkan proses utama (create, booking),
+// tapi kegagalannya tetap dicatat di log
+func logPriceHistoryError(scheduleID uuid.UUID, err error) {
+	if err != nil {
+		logger.Log.Warn("Failed to record schedule price history", zap.String("schedule_id", scheduleID.String()), zap.Error(err))
+	}
+}
+
+// containsAnyFold: true jika salah satu values ada di list
+func containsAnyFold(list []string, values []string) bool {
+	for _, v := range values {
+		if containsFold(list, v) {
+			return true
+		}
+	}
+	return false
+}
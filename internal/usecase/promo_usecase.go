@@ -1,18 +1,23 @@
 package usecase
 
 import (
+	"errors"
+	"fmt"
+	"movie-app/internal/delivery/http/dto/request"
 	"movie-app/internal/domain"
 	"movie-app/internal/repository"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 )
 
 type PromoUseCase interface {
-	CreatePromo(code string, discountType string, value float64, validUntil time.Time) (*domain.Promo, error)
+	CreatePromo(req request.CreatePromoRequest) (*domain.Promo, error)
 	GetAllPromos() ([]domain.Promo, error)
-	UpdatePromo(id uuid.UUID, code string, discountType string, value float64, validUntil time.Time) (*domain.Promo, error)
+	UpdatePromo(id uuid.UUID, req request.UpdatePromoRequest) (*domain.Promo, error)
 	DeletePromo(id uuid.UUID) error
+	GetRedemptions(promoID uuid.UUID, status string) ([]domain.PromoRedemption, map[string]int64, error)
 }
 
 type promoUseCase struct {
@@ -23,12 +28,37 @@ func NewPromoUseCase(promoRepo repository.PromoRepository) PromoUseCase {
 	return &promoUseCase{promoRepo}
 }
 
-func (uc *promoUseCase) CreatePromo(code string, discountType string, value float64, validUntil time.Time) (*domain.Promo, error) {
+func (uc *promoUseCase) CreatePromo(req request.CreatePromoRequest) (*domain.Promo, error) {
+	validFrom := req.ValidFrom
+	if validFrom.IsZero() {
+		validFrom = time.Now()
+	}
+	if !req.ValidUntil.After(validFrom) {
+		return nil, errors.New("valid_until must be after valid_from")
+	}
+
 	promo := &domain.Promo{
-		Code:          code,
-		DiscountType:  discountType,
-		DiscountValue: value,
-		ValidUntil:    validUntil,
+		Code:                 req.Code,
+		DiscountType:         req.DiscountType,
+		DiscountValue:        req.DiscountValue,
+		ValidFrom:            validFrom,
+		ValidUntil:           req.ValidUntil,
+		UsageLimit:           req.UsageLimit,
+		PerUserLimit:         req.PerUserLimit,
+		MinTransactionAmount: req.MinTransactionAmount,
+		MaxDiscountAmount:    req.MaxDiscountAmount,
+		MovieIDs:             req.MovieIDs,
+		Genres:               req.Genres,
+		StudioIDs:            req.StudioIDs,
+		Weekdays:             req.Weekdays,
+		StartTime:            req.StartTime,
+		EndTime:              req.EndTime,
+		SeatCategories:       req.SeatCategories,
+		DayTypes:             req.DayTypes,
+		FirstTimeOnly:        req.FirstTimeOnly,
+	}
+	if err := validatePromoTimeWindow(promo.StartTime, promo.EndTime); err != nil {
+		return nil, err
 	}
 	if err := uc.promoRepo.Create(promo); err != nil {
 		return nil, err
@@ -40,7 +70,7 @@ func (uc *promoUseCase) GetAllPromos() ([]domain.Promo, error) {
 	return uc.promoRepo.FindAll()
 }
 
-func (uc *promoUseCase) UpdatePromo(id uuid.UUID, code string, discountType string, value float64, validUntil time.Time) (*domain.Promo, error) {
+func (uc *promoUseCase) UpdatePromo(id uuid.UUID, req request.UpdatePromoRequest) (*domain.Promo, error) {
 	// Cek dulu datanya ada atau tidak
 	promo, err := uc.promoRepo.FindByID(id)
 	if err != nil {
@@ -48,17 +78,68 @@ func (uc *promoUseCase) UpdatePromo(id uuid.UUID, code string, discountType stri
 	}
 
 	// Update field jika tidak kosong (Partial Update logic sederhana)
-	if code != "" {
-		promo.Code = code
+	if req.Code != "" {
+		promo.Code = req.Code
+	}
+	if req.DiscountType != "" {
+		promo.DiscountType = req.DiscountType
+	}
+	if req.DiscountValue > 0 {
+		promo.DiscountValue = req.DiscountValue
+	}
+	if !req.ValidFrom.IsZero() {
+		promo.ValidFrom = req.ValidFrom
+	}
+	if !req.ValidUntil.IsZero() {
+		promo.ValidUntil = req.ValidUntil
+	}
+	if req.UsageLimit != nil {
+		promo.UsageLimit = *req.UsageLimit
+	}
+	if req.PerUserLimit != nil {
+		promo.PerUserLimit = *req.PerUserLimit
+	}
+	if req.MinTransactionAmount != nil {
+		promo.MinTransactionAmount = *req.MinTransactionAmount
+	}
+	if req.MaxDiscountAmount != nil {
+		promo.MaxDiscountAmount = *req.MaxDiscountAmount
+	}
+
+	// Eligibility: nil = tidak diubah
+	if req.MovieIDs != nil {
+		promo.MovieIDs = req.MovieIDs
+	}
+	if req.Genres != nil {
+		promo.Genres = req.Genres
 	}
-	if discountType != "" {
-		promo.DiscountType = discountType
+	if req.StudioIDs != nil {
+		promo.StudioIDs = req.StudioIDs
 	}
-	if value > 0 {
-		promo.DiscountValue = value
+	if req.Weekdays != nil {
+		promo.Weekdays = req.Weekdays
 	}
-	if !validUntil.IsZero() {
-		promo.ValidUntil = validUntil
+	if req.StartTime != nil {
+		promo.StartTime = *req.StartTime
+	}
+	if req.EndTime != nil {
+		promo.EndTime = *req.EndTime
+	}
+	if req.SeatCategories != nil {
+		promo.SeatCategories = req.SeatCategories
+	}
+	if req.DayTypes != nil {
+		promo.DayTypes = req.DayTypes
+	}
+	if req.FirstTimeOnly != nil {
+		promo.FirstTimeOnly = *req.FirstTimeOnly
+	}
+
+	if !promo.ValidUntil.After(promo.ValidFrom) {
+		return nil, errors.New("valid_until must be after valid_from")
+	}
+	if err := validatePromoTimeWindow(promo.StartTime, promo.EndTime); err != nil {
+		return nil, err
 	}
 
 	if err := uc.promoRepo.Update(promo); err != nil {
@@ -74,3 +155,113 @@ func (uc *promoUseCase) DeletePromo(id uuid.UUID) error {
 	}
 	return uc.promoRepo.Delete(id)
 }
+
+func (uc *promoUseCase) GetRedemptions(promoID uuid.UUID, status string) ([]domain.PromoRedemption, map[string]int64, error) {
+	if _, err := uc.promoRepo.FindByID(promoID); err != nil {
+		return nil, nil, errors.New("promo not found")
+	}
+
+	redemptions, err := uc.promoRepo.FindRedemptions(promoID, status)
+	if err != nil {
+		return nil, nil, err
+	}
+	counts, err := uc.promoRepo.CountRedemptionsByStatus(promoID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return redemptions, counts, nil
+}
+
+// validatePromoTimeWindow: start_time & end_time harus diisi berdua (atau kosong berdua)
+func validatePromoTimeWindow(start, end string) error {
+	if start == "" && end == "" {
+		return nil
+	}
+	if start == "" || end == "" {
+		return errors.New("start_time and end_time must be set together")
+	}
+	if _, err := time.Parse("15:04", start); err != nil {
+		return errors.New("start_time must use HH:MM format")
+	}
+	if _, err := time.Parse("15:04", end); err != nil {
+		return errors.New("end_time must use HH:MM format")
+	}
+	if start == end {
+		return errors.New("start_time and end_time cannot be equal")
+	}
+	return nil
+}
+
+// checkPromoEligibility mengevaluasi aturan targeting promo terhadap jadwal & kursi yang dipilih.
+// Error yang dikembalikan berisi alasan yang bisa langsung ditampilkan ke user.
+// dayTypes adalah jenis hari jadwal dari kalender (lihat calendarDayTypes).
+func checkPromoEligibility(promo *domain.Promo, schedule *domain.Schedule, seats []domain.Seat, isFirstTimeBuyer bool, dayTypes []string) error {
+	if len(promo.MovieIDs) > 0 && !containsFold(promo.MovieIDs, schedule.MovieID.String()) {
+		return errors.New("promo is not valid for this movie")
+	}
+
+	if len(promo.Genres) > 0 {
+		matched := false
+		for _, genre := range strings.Split(schedule.Movie.Genre, ",") {
+			if containsFold(promo.Genres, strings.TrimSpace(genre)) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return fmt.Errorf("promo is only valid for genres: %s", strings.Join(promo.Genres, ", "))
+		}
+	}
+
+	if len(promo.StudioIDs) > 0 && !containsFold(promo.StudioIDs, schedule.StudioID.String()) {
+		return errors.New("promo is not valid in this studio")
+	}
+
+	// Hari & jam tayang dievaluasi di jam lokal studio
+	localStart := scheduleLocalStart(schedule)
+	if len(promo.Weekdays) > 0 && !containsFold(promo.Weekdays, localStart.Weekday().String()) {
+		return fmt.Errorf("promo is only valid on: %s", strings.Join(promo.Weekdays, ", "))
+	}
+
+	if promo.StartTime != "" && promo.EndTime != "" {
+		showTime := localStart.Format("15:04")
+		if !isWithinTimeWindow(showTime, promo.StartTime, promo.EndTime) {
+			return fmt.Errorf("promo is only valid for showtimes between %s and %s", promo.StartTime, promo.EndTime)
+		}
+	}
+
+	if len(promo.SeatCategories) > 0 {
+		for _, seat := range seats {
+			if !containsFold(promo.SeatCategories, seat.Category) {
+				return fmt.Errorf("promo is only valid for seat categories: %s", strings.Join(promo.SeatCategories, ", "))
+			}
+		}
+	}
+
+	if !matchesDayTypes(promo.DayTypes, dayTypes) {
+		return fmt.Errorf("promo is only valid on: %s days", strings.Join(promo.DayTypes, ", "))
+	}
+
+	if promo.FirstTimeOnly && !isFirstTimeBuyer {
+		return errors.New("promo is only valid for first-time buyers")
+	}
+
+	return nil
+}
+
+// isWithinTimeWindow mengecek "HH:MM" ada di [start, end). Mendukung rentang lewat tengah malam (22:00-02:00).
+func isWithinTimeWindow(t, start, end string) bool {
+	if start <= end {
+		return t >= start && t < end
+	}
+	return t >= start || t < end
+}
+
+func containsFold(list []string, value string) bool {
+	for _, item := range list {
+		if strings.EqualFold(item, value) {
+			return true
+		}
+	}
+	return false
+}
@@ -5,13 +5,17 @@ import (
 	"encoding/csv"
 	"fmt"
 	"movie-app/internal/delivery/http/dto/response"
+	"movie-app/internal/domain"
 	"movie-app/internal/repository"
 )
 
+// ReportUseCase: laporan admin cabang hanya mencakup penjualan di cinema-nya
 type ReportUseCase interface {
-	GetTopMovies(limit int) ([]response.TopMovieResponse, error)
-	GetRevenueReport(mode string) ([]response.DailyRevenueResponse, error)
-	GenerateRevenueCSV(mode string) ([]byte, error)
+	GetTopMovies(scope domain.AdminScope, limit int) ([]response.TopMovieResponse, error)
+	GetRevenueReport(scope domain.AdminScope, mode string) ([]response.DailyRevenueResponse, error)
+	GenerateRevenueCSV(scope domain.AdminScope, mode string) ([]byte, error)
+	GetTicketTypeSales(scope domain.AdminScope) ([]response.TicketTypeSalesResponse, error)
+	GetDayTypeRevenue(scope domain.AdminScope) ([]response.DayTypeRevenueResponse, error)
 }
 
 type reportUseCase struct {
@@ -22,25 +26,33 @@ func NewReportUseCase(reportRepo repository.ReportRepository) ReportUseCase {
 	return &reportUseCase{reportRepo}
 }
 
-func (uc *reportUseCase) GetTopMovies(limit int) ([]response.TopMovieResponse, error) {
+func (uc *reportUseCase) GetTopMovies(scope domain.AdminScope, limit int) ([]response.TopMovieResponse, error) {
 	if limit <= 0 {
 		limit = 5 // Default top 5
 	}
-	return uc.reportRepo.GetTopMovies(limit)
+	return uc.reportRepo.GetTopMovies(limit, scope.CinemaFilter())
 }
 
-func (uc *reportUseCase) GetRevenueReport(mode string) ([]response.DailyRevenueResponse, error) {
+func (uc *reportUseCase) GetRevenueReport(scope domain.AdminScope, mode string) ([]response.DailyRevenueResponse, error) {
 	// Mode: 'day' atau 'month'
 	if mode != "month" {
 		mode = "day"
 	}
-	return uc.reportRepo.GetRevenueReport(mode)
+	return uc.reportRepo.GetRevenueReport(mode, scope.CinemaFilter())
+}
+
+func (uc *reportUseCase) GetTicketTypeSales(scope domain.AdminScope) ([]response.TicketTypeSalesResponse, error) {
+	return uc.reportRepo.GetTicketTypeSales(scope.CinemaFilter())
+}
+
+func (uc *reportUseCase) GetDayTypeRevenue(scope domain.AdminScope) ([]response.DayTypeRevenueResponse, error) {
+	return uc.reportRepo.GetDayTypeRevenue(scope.CinemaFilter())
 }
 
 // Implementasi Generate CSV
-func (uc *reportUseCase) GenerateRevenueCSV(mode string) ([]byte, error) {
+func (uc *reportUseCase) GenerateRevenueCSV(scope domain.AdminScope, mode string) ([]byte, error) {
 	// 1. Ambil Data dari Repo
-	data, err := uc.GetRevenueReport(mode)
+	data, err := uc.GetRevenueReport(scope, mode)
 	if err != nil {
 		return nil, err
 	}
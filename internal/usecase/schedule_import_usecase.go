@@ -0,0 +1,370 @@
+package usecase
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"movie-app/internal/config"
+	"movie-app/internal/delivery/http/dto/request"
+	"movie-app/internal/delivery/http/dto/response"
+	"movie-app/internal/domain"
+	"movie-app/internal/enums"
+	"movie-app/internal/repository"
+	"movie-app/pkg/utils"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// maxImportRows membatasi jumlah baris dalam 1 file import
+const maxImportRows = 1000
+
+// ErrImportInvalid: ada baris yang tidak valid, tidak ada jadwal yang disimpan
+var ErrImportInvalid = errors.New("some rows are invalid, nothing was imported")
+
+// Format file import
+const (
+	ImportFormatCSV  = "csv"
+	ImportFormatJSON = "json"
+)
+
+type ScheduleImportUseCase interface {
+	// Import memvalidasi semua baris lalu menyimpan semuanya dalam 1 db transaction (all-or-nothing).
+	// Jika ErrImportInvalid, laporan per baris tetap dikembalikan. Studio di luar scope admin ditolak per baris.
+	Import(scope domain.AdminScope, r io.Reader, format string, dryRun bool) (*response.ScheduleImportResponse, error)
+}
+
+type scheduleImportUseCase struct {
+	scheduleRepo repository.ScheduleRepository
+	movieRepo    repository.MovieRepository
+	studioRepo   repository.StudioRepository
+	cfg          *config.Config
+}
+
+func NewScheduleImportUseCase(
+	scheduleRepo repository.ScheduleRepository,
+	movieRepo repository.MovieRepository,
+	studioRepo repository.StudioRepository,
+	cfg *config.Config,
+) ScheduleImportUseCase {
+	return &scheduleImportUseCase{scheduleRepo, movieRepo, studioRepo, cfg}
+}
+
+// importRow adalah baris yang sudah di-parse dari file beserta error format-nya
+type importRow struct {
+	line   int
+	data   request.ScheduleImportRow
+	errors []string
+}
+
+func (uc *scheduleImportUseCase) Import(scope domain.AdminScope, r io.Reader, format string, dryRun bool) (*response.ScheduleImportResponse, error) {
+	// 1. Parse File
+	var rows []importRow
+	var err error
+	switch format {
+	case ImportFormatCSV:
+		rows, err = parseScheduleCSV(r)
+	case ImportFormatJSON:
+		rows, err = parseScheduleJSON(r)
+	default:
+		return nil, errors.New("format must be csv or json")
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, errors.New("file does not contain any rows")
+	}
+	if len(rows) > maxImportRows {
+		return nil, fmt.Errorf("file has %d rows, maximum is %d", len(rows), maxImportRows)
+	}
+
+	// 2. Validasi Per Baris (movie, studio, waktu, bentrok dengan jadwal lain & sesama baris)
+	report := &response.ScheduleImportResponse{DryRun: dryRun, Total: len(rows)}
+	movies := make(map[string]*domain.Movie)
+	studios := make(map[string]*domain.Studio)
+	var accepted []domain.Schedule
+	var acceptedIdx []int
+
+	for _, row := range rows {
+		result := response.ScheduleImportRowResponse{Row: row.line, Price: row.data.Price, Errors: row.errors}
+
+		movie := uc.resolveMovie(movies, row.data.Movie, &result)
+		studio := uc.resolveStudio(studios, row.data.Studio, &result)
+		if studio != nil && !scope.AllowsCinema(studio.CinemaID) {
+			result.Errors = append(result.Errors, fmt.Sprintf("studio %q is outside your cinemas", row.data.Studio))
+			result.StudioID, studio = nil, nil
+		}
+
+		// Jam tanpa offset dibaca sebagai jam lokal studio
+		loc := utils.LoadLocation(uc.cfg.DefaultTimezone)
+		if studio != nil {
+			loc = studio.Location()
+		}
+
+		var start, end time.Time
+		if row.data.StartTime == "" {
+			result.Errors = append(result.Errors, "start_time is required")
+		} else if start, err = parseImportTime(row.data.StartTime, loc); err != nil {
+			result.Errors = append(result.Errors, "start_time must be RFC3339 or YYYY-MM-DD HH:MM")
+		}
+		if row.data.EndTime != "" {
+			if end, err = parseImportTime(row.data.EndTime, loc); err != nil {
+				result.Errors = append(result.Errors, "end_time must be RFC3339 or YYYY-MM-DD HH:MM")
+			}
+		}
+		if row.data.Price < 0 {
+			result.Errors = append(result.Errors, "price must be 0 or more")
+		}
+
+		// Atribut tayang (opsional), 3D hanya di studio 3D
+		screening := domain.Schedule{
+			Format:           strings.ToLower(defaultString(row.data.Format, enums.Format2D)),
+			Features:         []string{},
+			AudioLanguage:    row.data.AudioLanguage,
+			SubtitleLanguage: row.data.SubtitleLanguage,
+		}
+		if screening.Format != enums.Format2D && screening.Format != enums.Format3D {
+			result.Errors = append(result.Errors, "format must be 2d or 3d")
+		} else if studio != nil {
+			if err := validateScreening(&screening, studio); err != nil {
+				result.Errors = append(result.Errors, err.Error())
+			}
+		}
+
+		if movie != nil && !start.IsZero() {
+			if row.data.EndTime == "" {
+				end = scheduleEndTime(start, movie, uc.cfg.PreShowMinutes)
+			} else if !end.IsZero() {
+				if !end.After(start) {
+					result.Errors = append(result.Errors, "end_time must be after start_time")
+				} else if err := validateScheduleDuration(start, end, movie); err != nil {
+					result.Errors = append(result.Errors, err.Error())
+				}
+			}
+			result.StartTime, result.EndTime = &start, &end
+		}
+
+		if len(result.Errors) == 0 {
+			isOverlap, err := uc.scheduleRepo.CheckOverlap(studio.ID, start, end, uuid.Nil)
+			if err != nil {
+				return nil, err
+			}
+			if isOverlap {
+				result.Errors = append(result.Errors, "overlaps with existing showtime")
+			}
+
+			buffer := time.Duration(studio.CleaningMinutes) * time.Minute
+			for i, prev := range accepted {
+				if prev.StudioID == studio.ID && prev.StartTime.Before(end.Add(buffer)) && prev.EndTime.Add(buffer).After(start) {
+					result.Errors = append(result.Errors, fmt.Sprintf("overlaps with row %d in this file", report.Rows[acceptedIdx[i]].Row))
+					break
+				}
+			}
+		}
+
+		if len(result.Errors) > 0 {
+			result.Status = enums.ImportRowInvalid
+			report.Invalid++
+		} else {
+			result.Status = enums.ImportRowValid
+			report.Valid++
+			accepted = append(accepted, domain.Schedule{
+				StudioID:  studio.ID,
+				MovieID:   movie.ID,
+				StartTime: start,
+				EndTime:   end,
+				Price:     row.data.Price,
+				Status:    enums.ScheduleActive,
+
+				Format:           screening.Format,
+				Features:         screening.Features,
+				AudioLanguage:    screening.AudioLanguage,
+				SubtitleLanguage: screening.SubtitleLanguage,
+			})
+			acceptedIdx = append(acceptedIdx, len(report.Rows))
+		}
+		report.Rows = append(report.Rows, result)
+	}
+
+	// 3. All-or-nothing: 1 baris gagal = tidak ada yang disimpan
+	if report.Invalid > 0 {
+		if !dryRun {
+			for _, i := range acceptedIdx {
+				report.Rows[i].Status = enums.ImportRowSkipped
+			}
+		}
+		return report, ErrImportInvalid
+	}
+	if dryRun {
+		return report, nil
+	}
+
+	// 4. Simpan Semua Jadwal (1 db transaction). Bentrok dicek ulang di dalam transaction karena jadwal lain
+	// bisa dibuat di antara validasi di atas & commit; jika terjadi, tidak ada baris yang disimpan.
+	if err := uc.scheduleRepo.CreateMany(accepted); err != nil {
+		if errors.Is(err, repository.ErrScheduleOverlap) {
+			return nil, fmt.Errorf("import aborted, nothing was saved: %w", err)
+		}
+		return nil, err
+	}
+	for i, idx := range acceptedIdx {
+		schedule := accepted[i]
+		report.Rows[idx].Status = enums.ImportRowCreated
+		report.Rows[idx].ScheduleID = &schedule.ID
+		logPriceHistoryError(schedule.ID, recordSchedulePrice(uc.scheduleRepo, schedule.ID, "", schedule.Price, enums.PriceChangeCreated, 0))
+	}
+	report.Created = len(accepted)
+
+	return report, nil
+}
+
+// resolveMovie mencari film berdasarkan UUID atau judul, hasilnya di-cache per file
+func (uc *scheduleImportUseCase) resolveMovie(cache map[string]*domain.Movie, value string, result *response.ScheduleImportRowResponse) *domain.Movie {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		result.Errors = append(result.Errors, "movie is required")
+		return nil
+	}
+
+	movie, ok := cache[value]
+	if !ok {
+		var err error
+		if id, parseErr := uuid.Parse(value); parseErr == nil {
+			movie, err = uc.movieRepo.FindByID(id)
+		} else {
+			movie, err = uc.movieRepo.FindByTitle(value)
+		}
+		if err != nil {
+			movie = nil
+		}
+		cache[value] = movie
+	}
+
+	if movie == nil {
+		result.Errors = append(result.Errors, fmt.Sprintf("movie %q not found", value))
+		return nil
+	}
+	result.MovieID = &movie.ID
+	return movie
+}
+
+// resolveStudio mencari studio berdasarkan UUID atau nama, hasilnya di-cache per file
+func (uc *scheduleImportUseCase) resolveStudio(cache map[string]*domain.Studio, value string, result *response.ScheduleImportRowResponse) *domain.Studio {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		result.Errors = append(result.Errors, "studio is required")
+		return nil
+	}
+
+	studio, ok := cache[value]
+	if !ok {
+		var err error
+		if id, parseErr := uuid.Parse(value); parseErr == nil {
+			studio, err = uc.studioRepo.FindByID(id)
+		} else {
+			studio, err = uc.studioRepo.FindByName(value)
+		}
+		if err != nil {
+			studio = nil
+		}
+		cache[value] = studio
+	}
+
+	if studio == nil {
+		result.Errors = append(result.Errors, fmt.Sprintf("studio %q not found", value))
+		return nil
+	}
+	result.StudioID = &studio.ID
+	return studio
+}
+
+// parseScheduleCSV membaca CSV dengan header. Kolom dikenali dari nama header, urutan bebas.
+func parseScheduleCSV(r io.Reader) ([]importRow, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, errors.New("failed to read csv header")
+	}
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))] = i
+	}
+	for _, required := range []string{"movie", "studio", "start_time", "price"} {
+		if _, ok := columns[required]; !ok {
+			return nil, fmt.Errorf("csv header must contain %q", required)
+		}
+	}
+
+	field := func(record []string, name string) string {
+		if i, ok := columns[name]; ok && i < len(record) {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+
+	var rows []importRow
+	line := 1
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		line++
+		if err != nil {
+			return nil, fmt.Errorf("invalid csv at line %d: %v", line, err)
+		}
+
+		row := importRow{line: line, data: request.ScheduleImportRow{
+			Movie:     field(record, "movie"),
+			Studio:    field(record, "studio"),
+			StartTime: field(record, "start_time"),
+			EndTime:   field(record, "end_time"),
+
+			Format:           field(record, "format"),
+			AudioLanguage:    field(record, "audio_language"),
+			SubtitleLanguage: field(record, "subtitle_language"),
+		}}
+		if price := field(record, "price"); price == "" {
+			row.errors = append(row.errors, "price is required")
+		} else if row.data.Price, err = strconv.ParseFloat(price, 64); err != nil {
+			row.errors = append(row.errors, "price must be a number")
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+// parseScheduleJSON membaca array JSON berisi request.ScheduleImportRow
+func parseScheduleJSON(r io.Reader) ([]importRow, error) {
+	var data []request.ScheduleImportRow
+	if err := json.NewDecoder(r).Decode(&data); err != nil {
+		return nil, errors.New("file must be a JSON array of schedules")
+	}
+
+	rows := make([]importRow, len(data))
+	for i := range data {
+		rows[i] = importRow{line: i + 1, data: data[i]}
+	}
+	return rows, nil
+}
+
+// parseImportTime menerima RFC3339 atau format spreadsheet (dibaca di zona waktu loc)
+func parseImportTime(value string, loc *time.Location) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	for _, layout := range []string{"2006-01-02 15:04", "2006-01-02T15:04", "2006-01-02 15:04:05"} {
+		if t, err := time.ParseInLocation(layout, value, loc); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, errors.New("invalid time format")
+}
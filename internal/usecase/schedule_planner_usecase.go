@@ -0,0 +1,311 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"movie-app/internal/config"
+	"movie-app/internal/delivery/http/dto/request"
+	"movie-app/internal/delivery/http/dto/response"
+	"movie-app/internal/domain"
+	"movie-app/internal/repository"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type SchedulePlannerUseCase interface {
+	// Plan membuat usulan jadwal tanpa menyimpan apapun
+	Plan(req request.PlanScheduleRequest) (*response.SchedulePlanResponse, error)
+	// Commit menyimpan usulan satu per satu lewat ScheduleUseCase.Create (validasi & cek bentrok yang sama)
+	Commit(req request.CommitSchedulePlanRequest) *response.SchedulePlanCommitResponse
+}
+
+type schedulePlannerUseCase struct {
+	scheduleRepo repository.ScheduleRepository
+	movieRepo    repository.MovieRepository
+	studioRepo   repository.StudioRepository
+	scheduleUC   ScheduleUseCase
+	cfg          *config.Config
+}
+
+func NewSchedulePlannerUseCase(
+	scheduleRepo repository.ScheduleRepository,
+	movieRepo repository.MovieRepository,
+	studioRepo repository.StudioRepository,
+	scheduleUC ScheduleUseCase,
+	cfg *config.Config,
+) SchedulePlannerUseCase {
+	return &schedulePlannerUseCase{scheduleRepo, movieRepo, studioRepo, scheduleUC, cfg}
+}
+
+// plannerMovie adalah state film selama proses planning
+type plannerMovie struct {
+	movie      *domain.Movie
+	weight     float64
+	target     int // 0 = tanpa batas
+	price      float64
+	showLength time.Duration // Pre-show + durasi film
+	planned    int
+	primeShows int
+}
+
+func (m *plannerMovie) hasQuota() bool {
+	return m.target == 0 || m.planned < m.target
+}
+
+func (uc *schedulePlannerUseCase) Plan(req request.PlanScheduleRequest) (*response.SchedulePlanResponse, error) {
+	// 1. Default & Validasi Input
+	days := req.Days
+	if days == 0 {
+		days = 7
+	}
+	slot := time.Duration(req.SlotMinutes) * time.Minute
+	if slot == 0 {
+		slot = 15 * time.Minute
+	}
+	opening := defaultString(req.OpeningTime, "10:00")
+	closing := defaultString(req.ClosingTime, "00:00")
+	primeStart := defaultString(req.PrimeTimeStart, "17:00")
+	primeEnd := defaultString(req.PrimeTimeEnd, "22:00")
+	if err := validatePromoTimeWindow(primeStart, primeEnd); err != nil {
+		return nil, errors.New("prime_time_start and prime_time_end must be different HH:MM times")
+	}
+
+	weekStart, err := time.Parse("2006-01-02", req.WeekStart)
+	if err != nil {
+		return nil, errors.New("week_start must use YYYY-MM-DD format")
+	}
+	openClock, _ := time.Parse("15:04", opening)
+	closeClock, _ := time.Parse("15:04", closing)
+
+	studios := make([]*domain.Studio, 0, len(req.StudioIDs))
+	seenStudios := make(map[uuid.UUID]bool)
+	for _, idStr := range req.StudioIDs {
+		id, _ := uuid.Parse(idStr)
+		if seenStudios[id] {
+			continue
+		}
+		seenStudios[id] = true
+		studio, err := uc.studioRepo.FindByID(id)
+		if err != nil {
+			return nil, fmt.Errorf("studio %s not found", idStr)
+		}
+		studios = append(studios, studio)
+	}
+
+	movies := make([]*plannerMovie, 0, len(req.Movies))
+	seenMovies := make(map[uuid.UUID]bool)
+	for _, m := range req.Movies {
+		id, _ := uuid.Parse(m.MovieID)
+		if seenMovies[id] {
+			return nil, fmt.Errorf("movie %s is listed more than once", m.MovieID)
+		}
+		seenMovies[id] = true
+		movie, err := uc.movieRepo.FindByID(id)
+		if err != nil {
+			return nil, fmt.Errorf("movie %s not found", m.MovieID)
+		}
+
+		pm := &plannerMovie{
+			movie:      movie,
+			weight:     m.Weight,
+			target:     m.TargetShows,
+			price:      m.Price,
+			showLength: time.Duration(uc.cfg.PreShowMinutes+movie.Duration) * time.Minute,
+		}
+		if pm.weight == 0 {
+			pm.weight = 1
+		}
+		if pm.price == 0 {
+			pm.price = req.Price
+		}
+		movies = append(movies, pm)
+	}
+
+	// 2. Isi Timeline per Hari per Studio (hari dulu agar kuota tersebar sepanjang minggu)
+	res := &response.SchedulePlanResponse{WeekStart: req.WeekStart, Days: days, Proposals: []response.ScheduleProposalResponse{}}
+	now := time.Now()
+	for d := 0; d < days; d++ {
+		day := weekStart.AddDate(0, 0, d)
+
+		for _, studio := range studios {
+			// Jam buka, tutup & prime time adalah jam lokal masing-masing studio
+			loc := studio.Location()
+			dayOpen := time.Date(day.Year(), day.Month(), day.Day(), openClock.Hour(), openClock.Minute(), 0, 0, loc)
+			dayClose := time.Date(day.Year(), day.Month(), day.Day(), closeClock.Hour(), closeClock.Minute(), 0, 0, loc)
+			if !dayClose.After(dayOpen) {
+				dayClose = dayClose.AddDate(0, 0, 1) // Tutup lewat tengah malam
+			}
+
+			existing, err := uc.scheduleRepo.FindActiveByStudio(studio.ID, dayOpen, dayClose)
+			if err != nil {
+				return nil, err
+			}
+			proposals := planStudioDay(studio, movies, existing, dayOpen, dayClose, now, slot, primeStart, primeEnd)
+			res.Proposals = append(res.Proposals, proposals...)
+		}
+	}
+
+	// 3. Ringkasan per Film
+	for _, p := range res.Proposals {
+		if p.PrimeTime {
+			res.PrimeTimeProposals++
+		}
+	}
+	res.TotalProposals = len(res.Proposals)
+
+	sort.SliceStable(movies, func(i, j int) bool { return movies[i].weight > movies[j].weight })
+	for _, m := range movies {
+		res.Movies = append(res.Movies, response.PlannerMovieSummaryResponse{
+			MovieID:        m.movie.ID,
+			Title:          m.movie.Title,
+			Weight:         m.weight,
+			TargetShows:    m.target,
+			PlannedShows:   m.planned,
+			PrimeTimeShows: m.primeShows,
+		})
+	}
+
+	return res, nil
+}
+
+// planStudioDay mengisi 1 hari di 1 studio secara greedy dari jam buka sampai tutup.
+// Slot prime time diberikan ke film dengan bobot tertinggi yang masih punya kuota,
+// slot lain dibagi rata sesuai bobot (film dengan tayang/bobot paling sedikit didahulukan).
+// Jadwal yang sudah ada + jeda bersih-bersih studio dilewati.
+func planStudioDay(studio *domain.Studio, movies []*plannerMovie, existing []domain.Schedule, dayOpen, dayClose, now time.Time, slot time.Duration, primeStart, primeEnd string) []response.ScheduleProposalResponse {
+	buffer := time.Duration(studio.CleaningMinutes) * time.Minute
+	var proposals []response.ScheduleProposalResponse
+
+	cursor := dayOpen
+	if cursor.Before(now) {
+		cursor = now.In(dayOpen.Location())
+	}
+
+	for {
+		cursor = roundUpToSlot(cursor, slot)
+		if !cursor.Before(dayClose) {
+			break
+		}
+
+		// Lompati jadwal lama yang (beserta jedanya) masih memakai studio di cursor
+		limit := dayClose
+		var next *domain.Schedule
+		blocked := false
+		for i := range existing {
+			blockStart := existing[i].StartTime.Add(-buffer)
+			blockEnd := existing[i].EndTime.Add(buffer)
+			if !cursor.Before(blockEnd) {
+				continue
+			}
+			if !cursor.Before(blockStart) {
+				cursor = blockEnd
+				blocked = true
+				break
+			}
+			if blockStart.Before(limit) {
+				limit = blockStart
+				next = &existing[i]
+			}
+			break // existing sudah urut start_time
+		}
+		if blocked {
+			continue
+		}
+
+		prime := isWithinTimeWindow(cursor.Format("15:04"), primeStart, primeEnd)
+		movie := pickPlannerMovie(movies, cursor, limit, prime)
+		if movie == nil {
+			if next == nil {
+				break
+			}
+			cursor = next.EndTime.Add(buffer)
+			continue
+		}
+
+		end := cursor.Add(movie.showLength)
+		movie.planned++
+		if prime {
+			movie.primeShows++
+		}
+		proposals = append(proposals, response.ScheduleProposalResponse{
+			StudioID:   studio.ID,
+			StudioName: studio.Name,
+			MovieID:    movie.movie.ID,
+			MovieTitle: movie.movie.Title,
+			StartTime:  cursor,
+			EndTime:    end,
+			Price:      movie.price,
+			PrimeTime:  prime,
+		})
+		cursor = end.Add(buffer)
+	}
+
+	return proposals
+}
+
+// pickPlannerMovie memilih film yang masih punya kuota & selesai sebelum limit
+func pickPlannerMovie(movies []*plannerMovie, start, limit time.Time, prime bool) *plannerMovie {
+	var best *plannerMovie
+	for _, m := range movies {
+		if !m.hasQuota() || start.Add(m.showLength).After(limit) {
+			continue
+		}
+		if best == nil {
+			best = m
+			continue
+		}
+
+		if prime {
+			// Bobot tertinggi, lalu yang paling sedikit dapat prime time
+			if m.weight > best.weight || (m.weight == best.weight && m.primeShows < best.primeShows) {
+				best = m
+			}
+			continue
+		}
+
+		// Weighted round-robin. Seri: bobot lebih kecil dulu agar film utama tersimpan untuk prime time
+		mRatio, bestRatio := float64(m.planned)/m.weight, float64(best.planned)/best.weight
+		if mRatio < bestRatio || (mRatio == bestRatio && m.weight < best.weight) {
+			best = m
+		}
+	}
+	return best
+}
+
+// roundUpToSlot membulatkan ke atas ke kelipatan slot dari tengah malam (zona waktu t)
+func roundUpToSlot(t time.Time, slot time.Duration) time.Time {
+	midnight := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	elapsed := t.Sub(midnight)
+	if rem := elapsed % slot; rem != 0 {
+		elapsed += slot - rem
+	}
+	return midnight.Add(elapsed)
+}
+
+func defaultString(value, fallback string) string {
+	if value == "" {
+		return fallback
+	}
+	return value
+}
+
+func (uc *schedulePlannerUseCase) Commit(req request.CommitSchedulePlanRequest) *response.SchedulePlanCommitResponse {
+	res := &response.SchedulePlanCommitResponse{Total: len(req.Schedules)}
+	for i, item := range req.Schedules {
+		result := response.PlanCommitResultResponse{Index: i, StudioID: item.StudioID, MovieID: item.MovieID, StartTime: item.StartTime}
+
+		// Alur create biasa: validasi movie/studio, durasi, bentrok & riwayat harga
+		schedule, err := uc.scheduleUC.Create(item)
+		if err != nil {
+			result.Error = err.Error()
+			res.Failed++
+		} else {
+			result.ScheduleID = &schedule.ID
+			res.Created++
+		}
+		res.Results = append(res.Results, result)
+	}
+	return res
+}
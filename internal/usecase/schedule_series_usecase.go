@@ -0,0 +1,376 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"movie-app/internal/config"
+	"movie-app/internal/delivery/http/dto/request"
+	"movie-app/internal/delivery/http/dto/response"
+	"movie-app/internal/domain"
+	"movie-app/internal/enums"
+	"movie-app/internal/repository"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// maxSeriesOccurrences membatasi jumlah jadwal yang dibuat dalam 1 request
+const maxSeriesOccurrences = 500
+
+// ErrSeriesConflict: ada occurrence yang bentrok & skip_conflicts tidak diaktifkan
+var ErrSeriesConflict = errors.New("some occurrences overlap with existing showtimes, nothing was created")
+
+type ScheduleSeriesUseCase interface {
+	// Create mengembalikan laporan per occurrence. Jika ErrSeriesConflict, laporan tetap dikembalikan.
+	Create(req request.CreateScheduleSeriesRequest) (*response.ScheduleSeriesResponse, error)
+	GetByID(id uuid.UUID) (*domain.ScheduleSeries, error)
+	Update(id uuid.UUID, scope string, req request.UpdateScheduleSeriesRequest) (*response.ScheduleSeriesResponse, error)
+	Delete(id uuid.UUID, scope string) (*response.ScheduleSeriesResponse, error)
+}
+
+type scheduleSeriesUseCase struct {
+	seriesRepo   repository.ScheduleSeriesRepository
+	scheduleRepo repository.ScheduleRepository
+	movieRepo    repository.MovieRepository
+	studioRepo   repository.StudioRepository
+	ticketRepo   repository.TicketRepository
+	cfg          *config.Config
+}
+
+func NewScheduleSeriesUseCase(
+	seriesRepo repository.ScheduleSeriesRepository,
+	scheduleRepo repository.ScheduleRepository,
+	movieRepo repository.MovieRepository,
+	studioRepo repository.StudioRepository,
+	ticketRepo repository.TicketRepository,
+	cfg *config.Config,
+) ScheduleSeriesUseCase {
+	return &scheduleSeriesUseCase{seriesRepo, scheduleRepo, movieRepo, studioRepo, ticketRepo, cfg}
+}
+
+func (uc *scheduleSeriesUseCase) Create(req request.CreateScheduleSeriesRequest) (*response.ScheduleSeriesResponse, error) {
+	// 1. Parsing & Validasi Input
+	studioID, _ := uuid.Parse(req.StudioID)
+	movieID, _ := uuid.Parse(req.MovieID)
+
+	studio, err := uc.studioRepo.FindByID(studioID)
+	if err != nil {
+		return nil, errors.New("studio not found")
+	}
+	movie, err := uc.movieRepo.FindByID(movieID)
+	if err != nil {
+		return nil, errors.New("movie not found")
+	}
+
+	// Tanggal & jam tayang series adalah jam lokal studio
+	startDate, err := time.ParseInLocation("2006-01-02", req.StartDate, studio.Location())
+	if err != nil {
+		return nil, errors.New("start_date must use YYYY-MM-DD format")
+	}
+	endDate, err := time.ParseInLocation("2006-01-02", req.EndDate, studio.Location())
+	if err != nil {
+		return nil, errors.New("end_date must use YYYY-MM-DD format")
+	}
+	if endDate.Before(startDate) {
+		return nil, errors.New("end_date must be on or after start_date")
+	}
+	if req.MaxPrice > 0 && req.MaxPrice < req.MinPrice {
+		return nil, errors.New("max_price must be greater than min_price")
+	}
+
+	// Atribut tayang sama untuk semua occurrence
+	var screening domain.Schedule
+	applyScreening(&screening, req.ScreeningRequest)
+	if err := validateScreening(&screening, studio); err != nil {
+		return nil, err
+	}
+
+	// 2. Generate Occurrence (durasi = iklan/trailer + film)
+	duration := time.Duration(uc.cfg.PreShowMinutes+movie.Duration) * time.Minute
+	buffer := time.Duration(studio.CleaningMinutes) * time.Minute
+	occurrences, err := generateOccurrences(startDate, endDate, req.Weekdays, req.StartTimes, duration)
+	if err != nil {
+		return nil, err
+	}
+	if len(occurrences) == 0 {
+		return nil, errors.New("date range and weekdays do not produce any occurrence")
+	}
+	if len(occurrences) > maxSeriesOccurrences {
+		return nil, fmt.Errorf("series would create %d schedules, maximum is %d", len(occurrences), maxSeriesOccurrences)
+	}
+
+	// 3. Cek Konflik per Occurrence (dengan jadwal lain & sesama occurrence di series ini, termasuk jeda bersih-bersih)
+	report := &response.ScheduleSeriesResponse{Total: len(occurrences)}
+	var schedules []domain.Schedule
+	for i, occ := range occurrences {
+		result := response.ScheduleOccurrenceResponse{StartTime: occ.Start, EndTime: occ.End, Status: enums.OccurrenceCreated}
+
+		isOverlap, err := uc.scheduleRepo.CheckOverlap(studioID, occ.Start, occ.End, uuid.Nil)
+		if err != nil {
+			return nil, err
+		}
+		if isOverlap {
+			result.Status = enums.OccurrenceConflict
+			result.Reason = "overlaps with existing showtime"
+		} else {
+			for _, prev := range occurrences[:i] {
+				if prev.Start.Before(occ.End.Add(buffer)) && prev.End.Add(buffer).After(occ.Start) {
+					result.Status = enums.OccurrenceConflict
+					result.Reason = fmt.Sprintf("overlaps with occurrence at %s in this series", prev.Start.Format("2006-01-02 15:04"))
+					break
+				}
+			}
+		}
+
+		if result.Status == enums.OccurrenceConflict {
+			report.Failed++
+		} else {
+			schedules = append(schedules, domain.Schedule{
+				StudioID:  studioID,
+				MovieID:   movieID,
+				StartTime: occ.Start,
+				EndTime:   occ.End,
+				Status:    enums.ScheduleActive,
+				Price:     req.Price,
+				MinPrice:  req.MinPrice,
+				MaxPrice:  req.MaxPrice,
+
+				Format:           screening.Format,
+				Features:         screening.Features,
+				AudioLanguage:    screening.AudioLanguage,
+				SubtitleLanguage: screening.SubtitleLanguage,
+			})
+		}
+		report.Occurrences = append(report.Occurrences, result)
+	}
+
+	if report.Failed > 0 && !req.SkipConflicts {
+		// Tidak ada yang dibuat, tandai occurrence lain sebagai skipped
+		for i := range report.Occurrences {
+			if report.Occurrences[i].Status == enums.OccurrenceCreated {
+				report.Occurrences[i].Status = enums.OccurrenceSkipped
+			}
+		}
+		return report, ErrSeriesConflict
+	}
+	if len(schedules) == 0 {
+		return report, ErrSeriesConflict
+	}
+
+	// 4. Simpan Series + Semua Occurrence (1 db transaction)
+	series := &domain.ScheduleSeries{
+		StudioID:   studioID,
+		MovieID:    movieID,
+		StartDate:  startDate,
+		EndDate:    endDate,
+		Weekdays:   req.Weekdays,
+		StartTimes: req.StartTimes,
+		Price:      req.Price,
+		MinPrice:   req.MinPrice,
+		MaxPrice:   req.MaxPrice,
+	}
+	if err := uc.seriesRepo.Create(series, schedules); err != nil {
+		if errors.Is(err, repository.ErrScheduleOverlap) {
+			// Jadwal lain dibuat di antara pengecekan di atas & commit: tidak ada yang disimpan
+			return uc.reportLateConflicts(report, schedules)
+		}
+		return nil, err
+	}
+
+	// 5. Lengkapi laporan dengan ID jadwal yang dibuat
+	report.SeriesID = series.ID
+	created := 0
+	for i := range report.Occurrences {
+		if report.Occurrences[i].Status != enums.OccurrenceCreated {
+			continue
+		}
+		schedule := schedules[created]
+		report.Occurrences[i].ScheduleID = &schedule.ID
+		logPriceHistoryError(schedule.ID, recordSchedulePrice(uc.scheduleRepo, schedule.ID, "", schedule.Price, enums.PriceChangeCreated, 0))
+		created++
+	}
+	report.Succeeded = created
+
+	return report, nil
+}
+
+func (uc *scheduleSeriesUseCase) GetByID(id uuid.UUID) (*domain.ScheduleSeries, error) {
+	series, err := uc.seriesRepo.FindByID(id)
+	if err != nil {
+		return nil, errors.New("schedule series not found")
+	}
+	series.Schedules, err = uc.seriesRepo.FindOccurrences(id, time.Time{})
+	if err != nil {
+		return nil, err
+	}
+	return series, nil
+}
+
+func (uc *scheduleSeriesUseCase) Update(id uuid.UUID, scope string, req request.UpdateScheduleSeriesRequest) (*response.ScheduleSeriesResponse, error) {
+	series, occurrences, err := uc.findScoped(id, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	// Nilai baru disimpan juga di series agar jadi acuan occurrence berikutnya
+	if req.Price != nil {
+		series.Price = *req.Price
+	}
+	if req.MinPrice != nil {
+		series.MinPrice = *req.MinPrice
+	}
+	if req.MaxPrice != nil {
+		series.MaxPrice = *req.MaxPrice
+	}
+	if series.MaxPrice > 0 && series.MaxPrice < series.MinPrice {
+		return nil, errors.New("max_price must be greater than min_price")
+	}
+
+	report := &response.ScheduleSeriesResponse{SeriesID: series.ID, Total: len(occurrences)}
+	scheduleIDs := make([]uuid.UUID, 0, len(occurrences))
+	for _, s := range occurrences {
+		scheduleIDs = append(scheduleIDs, s.ID)
+	}
+
+	if err := uc.seriesRepo.UpdatePrices(scheduleIDs, series.Price, series.MinPrice, series.MaxPrice); err != nil {
+		return nil, err
+	}
+	if err := uc.seriesRepo.Update(series); err != nil {
+		return nil, err
+	}
+
+	for i := range occurrences {
+		s := occurrences[i]
+		if s.Price != series.Price {
+			logPriceHistoryError(s.ID, recordSchedulePrice(uc.scheduleRepo, s.ID, "", series.Price, enums.PriceChangeManual, 0))
+		}
+		report.Occurrences = append(report.Occurrences, response.ScheduleOccurrenceResponse{
+			ScheduleID: &s.ID, StartTime: s.StartTime.In(s.Studio.Location()), EndTime: s.EndTime.In(s.Studio.Location()), Status: enums.OccurrenceUpdated,
+		})
+	}
+	report.Succeeded = len(occurrences)
+
+	return report, nil
+}
+
+func (uc *scheduleSeriesUseCase) Delete(id uuid.UUID, scope string) (*response.ScheduleSeriesResponse, error) {
+	series, occurrences, err := uc.findScoped(id, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &response.ScheduleSeriesResponse{SeriesID: series.ID, Total: len(occurrences)}
+	var deletable []uuid.UUID
+	for i := range occurrences {
+		s := occurrences[i]
+		result := response.ScheduleOccurrenceResponse{ScheduleID: &s.ID, StartTime: s.StartTime.In(s.Studio.Location()), EndTime: s.EndTime.In(s.Studio.Location()), Status: enums.OccurrenceDeleted}
+
+		// Jadwal yang sudah ada tiketnya tidak ikut dihapus
+		booked, err := uc.ticketRepo.GetBookedSeats(s.ID)
+		if err != nil {
+			return nil, err
+		}
+		if len(booked) > 0 {
+			result.Status = enums.OccurrenceSkipped
+			result.Reason = fmt.Sprintf("%d tickets already sold", len(booked))
+			report.Failed++
+		} else {
+			deletable = append(deletable, s.ID)
+		}
+		report.Occurrences = append(report.Occurrences, result)
+	}
+
+	if err := uc.seriesRepo.DeleteOccurrences(deletable); err != nil {
+		return nil, err
+	}
+	report.Succeeded = len(deletable)
+
+	// Series ikut dihapus jika tidak ada occurrence yang tersisa
+	if scope == enums.SeriesScopeAll && report.Failed == 0 {
+		if err := uc.seriesRepo.Delete(series.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	return report, nil
+}
+
+// findScoped mengambil series & occurrence sesuai scope (all / future)
+func (uc *scheduleSeriesUseCase) findScoped(id uuid.UUID, scope string) (*domain.ScheduleSeries, []domain.Schedule, error) {
+	series, err := uc.seriesRepo.FindByID(id)
+	if err != nil {
+		return nil, nil, errors.New("schedule series not found")
+	}
+
+	var from time.Time
+	switch scope {
+	case "", enums.SeriesScopeFuture:
+		from = time.Now()
+	case enums.SeriesScopeAll:
+	default:
+		return nil, nil, errors.New("scope must be all or future")
+	}
+
+	occurrences, err := uc.seriesRepo.FindOccurrences(id, from)
+	if err != nil {
+		return nil, nil, err
+	}
+	return series, occurrences, nil
+}
+
+type occurrence struct {
+	Start time.Time
+	End   time.Time
+}
+
+// generateOccurrences membuat kombinasi tanggal x jam tayang, urut berdasarkan waktu mulai
+func generateOccurrences(startDate, endDate time.Time, weekdays, startTimes []string, duration time.Duration) ([]occurrence, error) {
+	var occurrences []occurrence
+	for day := startDate; !day.After(endDate); day = day.AddDate(0, 0, 1) {
+		if len(weekdays) > 0 && !containsFold(weekdays, day.Weekday().String()) {
+			continue
+		}
+		for _, st := range startTimes {
+			clock, err := time.Parse("15:04", strings.TrimSpace(st))
+			if err != nil {
+				return nil, fmt.Errorf("invalid start time %s, must use HH:MM format", st)
+			}
+			start := time.Date(day.Year(), day.Month(), day.Day(), clock.Hour(), clock.Minute(), 0, 0, day.Location())
+			occurrences = append(occurrences, occurrence{Start: start, End: start.Add(duration)})
+		}
+	}
+
+	// startTimes belum tentu urut
+	sort.Slice(occurrences, func(i, j int) bool {
+		return occurrences[i].Start.Before(occurrences[j].Start)
+	})
+	return occurrences, nil
+}
+
+// reportLateConflicts menandai occurrence yang ternyata bentrok saat disimpan sebagai conflict
+// & sisanya sebagai skipped (series batal dibuat seluruhnya)
+func (uc *scheduleSeriesUseCase) reportLateConflicts(report *response.ScheduleSeriesResponse, schedules []domain.Schedule) (*response.ScheduleSeriesResponse, error) {
+	created := 0
+	for i := range report.Occurrences {
+		occ := &report.Occurrences[i]
+		if occ.Status != enums.OccurrenceCreated {
+			continue
+		}
+		schedule := schedules[created]
+		created++
+
+		occ.Status = enums.OccurrenceSkipped
+		isOverlap, err := uc.scheduleRepo.CheckOverlap(schedule.StudioID, schedule.StartTime, schedule.EndTime, uuid.Nil)
+		if err != nil {
+			return nil, err
+		}
+		if isOverlap {
+			occ.Status = enums.OccurrenceConflict
+			occ.Reason = "overlaps with existing showtime"
+			report.Failed++
+		}
+	}
+	return report, ErrSeriesConflict
+}
@@ -2,22 +2,139 @@ package usecase
 
 import (
 	"errors"
+	"fmt"
 	"math"
+	"movie-app/internal/config"
 	"movie-app/internal/delivery/http/dto/request"
+	"movie-app/internal/delivery/http/dto/response"
 	"movie-app/internal/domain"
+	"movie-app/internal/enums"
 	"movie-app/internal/repository"
+	"movie-app/pkg/mailer"
 	"movie-app/pkg/utils"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 )
 
+// scheduleLocalStart mengembalikan jam mulai dalam zona waktu studio (Studio harus sudah di-preload)
+func scheduleLocalStart(schedule *domain.Schedule) time.Time {
+	return schedule.StartTime.In(schedule.Studio.Location())
+}
+
+// formatLocalTime memformat waktu untuk email dalam jam lokal studio, mis. "24 Dec 2025 19:00 WIB"
+func formatLocalTime(t time.Time, studio *domain.Studio) string {
+	return t.In(studio.Location()).Format("02 Jan 2006 15:04 MST")
+}
+
+// scheduleEndTime menghitung end time otomatis: start + iklan/trailer (pre-show) + durasi film
+func scheduleEndTime(start time.Time, movie *domain.Movie, preShowMinutes int) time.Time {
+	return start.Add(time.Duration(preShowMinutes+movie.Duration) * time.Minute)
+}
+
+// validateScheduleDuration menolak end time manual yang lebih pendek dari durasi film
+func validateScheduleDuration(start, end time.Time, movie *domain.Movie) error {
+	if end.Sub(start) < time.Duration(movie.Duration)*time.Minute {
+		return errors.New("end_time is shorter than movie duration")
+	}
+	return nil
+}
+
+// scheduleSalesWindow mengembalikan jendela penjualan efektif jadwal (kolom jadwal, jika kosong default config).
+// open kosong (zero) = sudah dijual sejak jadwal dibuat.
+func scheduleSalesWindow(schedule *domain.Schedule, cfg *config.Config) (openAt, closeAt time.Time) {
+	if schedule.SalesOpenAt != nil {
+		openAt = *schedule.SalesOpenAt
+	} else if cfg.SalesOpenDaysBefore > 0 {
+		openAt = schedule.StartTime.AddDate(0, 0, -cfg.SalesOpenDaysBefore)
+	}
+	if schedule.SalesCloseAt != nil {
+		closeAt = *schedule.SalesCloseAt
+	} else {
+		closeAt = schedule.StartTime.Add(time.Duration(cfg.BookingCutoffMinutes) * time.Minute)
+	}
+	return openAt, closeAt
+}
+
+// scheduleNotSellableReason mengembalikan alasan jadwal tidak bisa dijual saat now ("" = bisa dijual)
+func scheduleNotSellableReason(schedule *domain.Schedule, cfg *config.Config, now time.Time) string {
+	openAt, closeAt := scheduleSalesWindow(schedule, cfg)
+	switch {
+	case schedule.Status == enums.ScheduleCancelled:
+		return enums.SalesCancelled
+	case now.Before(openAt):
+		return enums.SalesNotOpen
+	case !now.Before(closeAt):
+		return enums.SalesClosed
+	}
+	return ""
+}
+
+// validateSalesWindow memastikan jendela penjualan efektif masuk akal untuk jadwal tsb
+func validateSalesWindow(schedule *domain.Schedule, cfg *config.Config) error {
+	openAt, closeAt := scheduleSalesWindow(schedule, cfg)
+	if !openAt.IsZero() && !closeAt.After(openAt) {
+		return errors.New("sales_close_at must be after sales_open_at")
+	}
+	if schedule.SalesOpenAt != nil && !schedule.SalesOpenAt.Before(schedule.EndTime) {
+		return errors.New("sales_open_at must be before the schedule ends")
+	}
+	if schedule.SalesCloseAt != nil && schedule.SalesCloseAt.After(schedule.EndTime) {
+		return errors.New("sales_close_at cannot be after the schedule ends")
+	}
+	return nil
+}
+
+// applyScreening mengisi atribut tayang dari request (format default 2d)
+func applyScreening(schedule *domain.Schedule, req request.ScreeningRequest) {
+	schedule.Format = defaultString(req.Format, enums.Format2D)
+	schedule.Features = normalizeTags(req.Features)
+	schedule.AudioLanguage = req.AudioLanguage
+	schedule.SubtitleLanguage = req.SubtitleLanguage
+}
+
+// validateScreening memastikan format & fitur tayang didukung studio (mis. 3D hanya di studio 3D)
+func validateScreening(schedule *domain.Schedule, studio *domain.Studio) error {
+	if schedule.Format == enums.Format3D && !studio.Supports(enums.Feature3D) {
+		return fmt.Errorf("studio %s does not support 3D screenings", studio.Name)
+	}
+	for _, f := range schedule.Features {
+		if !studio.Supports(f) {
+			return fmt.Errorf("studio %s does not support %s", studio.Name, f)
+		}
+	}
+	return nil
+}
+
+// normalizeTags menyeragamkan daftar fitur (lowercase, tanpa duplikat). Selalu non-nil agar tersimpan sebagai '{}'.
+func normalizeTags(tags []string) []string {
+	result := []string{}
+	for _, t := range tags {
+		t = strings.ToLower(strings.TrimSpace(t))
+		if t != "" && !containsFold(result, t) {
+			result = append(result, t)
+		}
+	}
+	return result
+}
+
 type ScheduleUseCase interface {
 	Create(req request.CreateScheduleRequest) (*domain.Schedule, error)
 	GetByID(id uuid.UUID) (*domain.Schedule, error)
-	GetAll(page int, limit int) ([]domain.Schedule, *utils.PaginationMeta, error)
+	GetAll(filter request.ScheduleFilterRequest, page int, limit int) ([]domain.Schedule, *utils.PaginationMeta, error)
+	// Update ditolak jika ada tiket terjual & studio diganti tanpa pemetaan kursi. Pemegang tiket diberi email
+	// jika waktu / studio berubah.
 	Update(id uuid.UUID, req request.UpdateScheduleRequest) (*domain.Schedule, error)
+	// PreviewUpdate (dry-run) menghitung dampak Update tanpa menyimpan
+	PreviewUpdate(id uuid.UUID, req request.UpdateScheduleRequest) (*response.ScheduleUpdateImpactResponse, error)
+	// Delete ditolak jika sudah ada tiket terjual, gunakan Cancel
 	Delete(id uuid.UUID) error
+	// Cancel membatalkan jadwal, me-refund / mengkredit transaksi paid, membatalkan yang pending,
+	// lalu mengirim email ke pemegang tiket
+	Cancel(id uuid.UUID, req request.CancelScheduleRequest) (*response.ScheduleCancellationResponse, error)
+	GetPriceHistory(id uuid.UUID) ([]domain.SchedulePriceHistory, error)
 	// Update bisa Anda tambahkan sendiri nanti sbg latihan
 }
 
@@ -25,6 +142,11 @@ type scheduleUseCase struct {
 	scheduleRepo repository.ScheduleRepository
 	movieRepo    repository.MovieRepository
 	studioRepo   repository.StudioRepository
+	ticketRepo   repository.TicketRepository
+	transRepo    repository.TransactionRepository
+	cinemaRepo   repository.CinemaRepository
+	mailer       *mailer.Mailer
+	cfg          *config.Config
 }
 
 // Butuh repo lain untuk validasi ID
@@ -32,8 +154,13 @@ func NewScheduleUseCase(
 	sRepo repository.ScheduleRepository,
 	mRepo repository.MovieRepository,
 	stRepo repository.StudioRepository,
+	tRepo repository.TicketRepository,
+	trxRepo repository.TransactionRepository,
+	cRepo repository.CinemaRepository,
+	mailer *mailer.Mailer,
+	cfg *config.Config,
 ) ScheduleUseCase {
-	return &scheduleUseCase{sRepo, mRepo, stRepo}
+	return &scheduleUseCase{sRepo, mRepo, stRepo, tRepo, trxRepo, cRepo, mailer, cfg}
 }
 
 func (uc *scheduleUseCase) Create(req request.CreateScheduleRequest) (*domain.Schedule, error) {
@@ -42,17 +169,25 @@ func (uc *scheduleUseCase) Create(req request.CreateScheduleRequest) (*domain.Sc
 	movieID, _ := uuid.Parse(req.MovieID)
 
 	// 2. Validasi Exist (Apakah Studio & Movie ada?)
-	_, err := uc.studioRepo.FindByID(studioID)
+	studio, err := uc.studioRepo.FindByID(studioID)
 	if err != nil {
 		return nil, errors.New("studio not found")
 	}
-	_, err = uc.movieRepo.FindByID(movieID)
+	movie, err := uc.movieRepo.FindByID(movieID)
 	if err != nil {
 		return nil, errors.New("movie not found")
 	}
 
-	// 3. Validasi Konflik Jadwal
-	isOverlap, err := uc.scheduleRepo.CheckOverlap(studioID, req.StartTime, req.EndTime, uuid.Nil)
+	// End time otomatis dari durasi film jika tidak diisi manual
+	endTime := req.EndTime
+	if endTime.IsZero() {
+		endTime = scheduleEndTime(req.StartTime, movie, uc.cfg.PreShowMinutes)
+	} else if err := validateScheduleDuration(req.StartTime, endTime, movie); err != nil {
+		return nil, err
+	}
+
+	// 3. Validasi Konflik Jadwal (termasuk jeda bersih-bersih studio)
+	isOverlap, err := uc.scheduleRepo.CheckOverlap(studioID, req.StartTime, endTime, uuid.Nil)
 	if err != nil {
 		return nil, err
 	}
@@ -60,31 +195,39 @@ func (uc *scheduleUseCase) Create(req request.CreateScheduleRequest) (*domain.Sc
 		return nil, errors.New("schedule overlaps with existing showtime")
 	}
 
-	// 4. Dynamic Pricing (Prime Time Logic)
-	finalPrice := req.Price
-	hour := req.StartTime.Hour()
-	weekday := req.StartTime.Weekday()
-
-	// pembuatan otomatis menambahkan 10000, jika terjadi di saturday dan sunday
-	isPrimeTime := hour >= 17 || weekday == time.Saturday || weekday == time.Sunday
-	if isPrimeTime {
-		finalPrice += 10000
+	if req.MaxPrice > 0 && req.MaxPrice < req.MinPrice {
+		return nil, errors.New("max_price must be greater than min_price")
 	}
 
-	// 5. Simpan ke Database
+	// 4. Simpan ke Database
+	// Price adalah harga dasar. Surcharge (weekend, prime time, dll) dihitung saat booking oleh pricing rules.
 	schedule := &domain.Schedule{
 		StudioID:  studioID,
 		MovieID:   movieID,
 		StartTime: req.StartTime,
-		EndTime:   req.EndTime,
-		Price:     finalPrice,
+		EndTime:   endTime,
+		Price:     req.Price,
+		MinPrice:  req.MinPrice,
+		MaxPrice:  req.MaxPrice,
+		Status:    enums.ScheduleActive,
+
+		SalesOpenAt:  req.SalesOpenAt,
+		SalesCloseAt: req.SalesCloseAt,
+	}
+	if err := validateSalesWindow(schedule, uc.cfg); err != nil {
+		return nil, err
+	}
+	applyScreening(schedule, req.ScreeningRequest)
+	if err := validateScreening(schedule, studio); err != nil {
+		return nil, err
 	}
 
 	if err := uc.scheduleRepo.Create(schedule); err != nil {
 		return nil, err
 	}
+	logPriceHistoryError(schedule.ID, recordSchedulePrice(uc.scheduleRepo, schedule.ID, "", schedule.Price, enums.PriceChangeCreated, 0))
 
-	// 6. Ambil data lengkap (Reload dari DB agar Preload Studio & Movie muncul)
+	// 5. Ambil data lengkap (Reload dari DB agar Preload Studio & Movie muncul)
 	// --- Baris yang error dihapus, diganti logic fetch ulang yang proper ---
 	createdSchedule, err := uc.scheduleRepo.FindByID(schedule.ID)
 	if err != nil {
@@ -94,31 +237,99 @@ func (uc *scheduleUseCase) Create(req request.CreateScheduleRequest) (*domain.Sc
 	return createdSchedule, nil
 }
 
+// scheduleUpdatePlan adalah hasil perhitungan Update sebelum disimpan (dipakai juga untuk dry-run)
+type scheduleUpdatePlan struct {
+	schedule     *domain.Schedule        // Sudah berisi nilai baru
+	priceChanged bool                    // Harga dasar berubah -> catat riwayat harga
+	seatMoves    map[uuid.UUID]uuid.UUID // Ticket ID -> kursi di studio baru
+	impact       *response.ScheduleUpdateImpactResponse
+}
+
 func (uc *scheduleUseCase) Update(id uuid.UUID, req request.UpdateScheduleRequest) (*domain.Schedule, error) {
+	plan, err := uc.planUpdate(id, req)
+	if err != nil {
+		return nil, err
+	}
+	if !plan.impact.CanApply {
+		return nil, errors.New(strings.Join(plan.impact.Issues, "; "))
+	}
+
+	// 5. Simpan Perubahan (jadwal + pindah kursi tiket dalam 1 db transaction)
+	if err := uc.scheduleRepo.UpdateWithSeatMoves(plan.schedule, plan.seatMoves); err != nil {
+		return nil, err
+	}
+	if plan.priceChanged {
+		logPriceHistoryError(id, recordSchedulePrice(uc.scheduleRepo, id, "", plan.schedule.Price, enums.PriceChangeManual, 0))
+	}
+
+	// 6. Return data terbaru (reload agar relasi Studio ikut berubah)
+	updated, err := uc.scheduleRepo.FindByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	// 7. Kabari pemegang tiket jika waktu / studio berubah
+	if plan.impact.NotifyHolders {
+		go uc.sendScheduleChangeEmails(updated, plan.impact)
+	}
+	return updated, nil
+}
+
+func (uc *scheduleUseCase) PreviewUpdate(id uuid.UUID, req request.UpdateScheduleRequest) (*response.ScheduleUpdateImpactResponse, error) {
+	plan, err := uc.planUpdate(id, req)
+	if err != nil {
+		return nil, err
+	}
+	plan.impact.DryRun = true
+	return plan.impact, nil
+}
+
+// planUpdate memvalidasi input & menghitung dampaknya ke tiket yang sudah terjual tanpa menyimpan apapun.
+// Error dikembalikan untuk input yang tidak valid, sedangkan masalah terkait tiket terjual dicatat di impact.Issues.
+func (uc *scheduleUseCase) planUpdate(id uuid.UUID, req request.UpdateScheduleRequest) (*scheduleUpdatePlan, error) {
 	// 1. Cek apakah data jadwal ada?
 	schedule, err := uc.scheduleRepo.FindByID(id)
 	if err != nil {
 		return nil, errors.New("schedule not found")
 	}
+	if schedule.Status == enums.ScheduleCancelled {
+		return nil, errors.New("cannot update a cancelled schedule")
+	}
+
+	impact := &response.ScheduleUpdateImpactResponse{
+		ScheduleID:   id,
+		OldStudioID:  schedule.StudioID,
+		OldStartTime: schedule.StartTime,
+		OldEndTime:   schedule.EndTime,
+		Issues:       []string{},
+		SeatMoves:    []response.SeatMoveResponse{},
+	}
 
 	// 2. Parsing UUID & Validasi Input jika ada perubahan
 	// Kalau user kirim StudioID baru, validasi
+	studio := &schedule.Studio
 	if req.StudioID != "" {
 		sID, _ := uuid.Parse(req.StudioID)
-		if _, err := uc.studioRepo.FindByID(sID); err != nil {
+		if studio, err = uc.studioRepo.FindByID(sID); err != nil {
 			return nil, errors.New("studio not found")
 		}
 		schedule.StudioID = sID
 	}
 
 	// Kalau user kirim MovieID baru, validasi
+	movieChanged := false
 	if req.MovieID != "" {
 		mID, _ := uuid.Parse(req.MovieID)
 		if _, err := uc.movieRepo.FindByID(mID); err != nil {
 			return nil, errors.New("movie not found")
 		}
+		movieChanged = mID != schedule.MovieID
 		schedule.MovieID = mID
 	}
+	movie, err := uc.movieRepo.FindByID(schedule.MovieID)
+	if err != nil {
+		return nil, errors.New("movie not found")
+	}
 
 	// 3. Update Waktu & Cek Konflik
 	// Kita perlu tau waktu "baru" untuk pengecekan konflik
@@ -130,6 +341,12 @@ func (uc *scheduleUseCase) Update(id uuid.UUID, req request.UpdateScheduleReques
 	}
 	if !req.EndTime.IsZero() {
 		newEnd = req.EndTime
+		if err := validateScheduleDuration(newStart, newEnd, movie); err != nil {
+			return nil, err
+		}
+	} else if movieChanged || !req.StartTime.IsZero() {
+		// Start/film berubah tanpa end time manual -> hitung ulang dari durasi film
+		newEnd = scheduleEndTime(newStart, movie, uc.cfg.PreShowMinutes)
 	}
 
 	// Cek konflik dengan jadwal lain (kecuali dirinya sendiri 'id')
@@ -146,29 +363,228 @@ func (uc *scheduleUseCase) Update(id uuid.UUID, req request.UpdateScheduleReques
 	schedule.EndTime = newEnd
 
 	// 4. Update Harga (Optional)
+	priceChanged := req.Price > 0 && req.Price != schedule.Price
 	if req.Price > 0 {
 		schedule.Price = req.Price
+		schedule.LegacyPricing = false // Harga baru = harga dasar, pricing rules berlaku
+	}
+	if req.MinPrice != nil {
+		schedule.MinPrice = *req.MinPrice
+	}
+	if req.MaxPrice != nil {
+		schedule.MaxPrice = *req.MaxPrice
+	}
+	if schedule.MaxPrice > 0 && schedule.MaxPrice < schedule.MinPrice {
+		return nil, errors.New("max_price must be greater than min_price")
+	}
+
+	// 4b. Jendela Penjualan (Optional)
+	if req.ResetSalesWindow {
+		schedule.SalesOpenAt, schedule.SalesCloseAt = nil, nil
+	}
+	if req.SalesOpenAt != nil {
+		schedule.SalesOpenAt = req.SalesOpenAt
+	}
+	if req.SalesCloseAt != nil {
+		schedule.SalesCloseAt = req.SalesCloseAt
+	}
+	if err := validateSalesWindow(schedule, uc.cfg); err != nil {
+		return nil, err
+	}
+
+	// 4c. Atribut Tayang (divalidasi ulang juga saat studio diganti)
+	if req.Format != nil {
+		schedule.Format = defaultString(*req.Format, enums.Format2D)
+	}
+	if req.Features != nil {
+		schedule.Features = normalizeTags(req.Features)
+	}
+	if req.AudioLanguage != nil {
+		schedule.AudioLanguage = *req.AudioLanguage
+	}
+	if req.SubtitleLanguage != nil {
+		schedule.SubtitleLanguage = *req.SubtitleLanguage
+	}
+	if err := validateScreening(schedule, studio); err != nil {
+		return nil, err
+	}
+
+	// 5. Hitung Dampak ke Tiket Terjual
+	impact.NewStudioID = schedule.StudioID
+	impact.NewStartTime = schedule.StartTime
+	impact.NewEndTime = schedule.EndTime
+	impact.StudioChanged = impact.NewStudioID != impact.OldStudioID
+	impact.TimeChanged = !impact.NewStartTime.Equal(impact.OldStartTime) || !impact.NewEndTime.Equal(impact.OldEndTime)
+
+	booked, err := uc.ticketRepo.GetBookedSeats(id)
+	if err != nil {
+		return nil, err
 	}
+	impact.SoldTickets = len(booked)
+	transactionIDs := make(map[uuid.UUID]bool)
+	for _, t := range booked {
+		transactionIDs[t.TransactionID] = true
+	}
+	impact.AffectedTransactions = len(transactionIDs)
 
-	// 5. Simpan Perubahan
-	if err := uc.scheduleRepo.Update(schedule); err != nil {
+	plan := &scheduleUpdatePlan{schedule: schedule, priceChanged: priceChanged, impact: impact}
+	if len(booked) > 0 {
+		if movieChanged {
+			impact.Issues = append(impact.Issues, "cannot change movie after tickets are sold, cancel the schedule instead")
+		}
+		if impact.StudioChanged {
+			plan.seatMoves, err = uc.planSeatMoves(booked, impact, req)
+			if err != nil {
+				return nil, err
+			}
+		}
+		impact.NotifyHolders = impact.TimeChanged || impact.StudioChanged
+	}
+	impact.CanApply = len(impact.Issues) == 0
+
+	return plan, nil
+}
+
+// planSeatMoves memetakan kursi tiket terjual ke kursi studio baru.
+// seat_mapping eksplisit diutamakan, sisanya dipetakan ke baris & nomor yang sama jika auto_map_seats aktif.
+func (uc *scheduleUseCase) planSeatMoves(booked []domain.Ticket, impact *response.ScheduleUpdateImpactResponse, req request.UpdateScheduleRequest) (map[uuid.UUID]uuid.UUID, error) {
+	oldSeats, err := uc.studioRepo.GetSeatsByStudioID(impact.OldStudioID)
+	if err != nil {
+		return nil, err
+	}
+	newSeats, err := uc.studioRepo.GetSeatsByStudioID(impact.NewStudioID)
+	if err != nil {
 		return nil, err
 	}
 
-	// 6. Return data terbaru
-	return schedule, nil
+	seatLabel := func(seat domain.Seat) string {
+		return fmt.Sprintf("%s%d", seat.RowCode, seat.SeatNumber)
+	}
+	oldByID := make(map[uuid.UUID]domain.Seat, len(oldSeats))
+	for _, seat := range oldSeats {
+		oldByID[seat.ID] = seat
+	}
+	newByID := make(map[uuid.UUID]domain.Seat, len(newSeats))
+	newByLabel := make(map[string]domain.Seat, len(newSeats))
+	for _, seat := range newSeats {
+		newByID[seat.ID] = seat
+		newByLabel[seatLabel(seat)] = seat
+	}
+
+	soldSeats := make(map[uuid.UUID]bool, len(booked))
+	for _, t := range booked {
+		soldSeats[t.SeatID] = true
+	}
+
+	explicit := make(map[uuid.UUID]uuid.UUID, len(req.SeatMapping))
+	for _, m := range req.SeatMapping {
+		fromID, _ := uuid.Parse(m.FromSeatID)
+		toID, _ := uuid.Parse(m.ToSeatID)
+		if !soldSeats[fromID] {
+			impact.Issues = append(impact.Issues, fmt.Sprintf("seat_mapping from_seat_id %s has no sold ticket", m.FromSeatID))
+			continue
+		}
+		if _, ok := newByID[toID]; !ok {
+			impact.Issues = append(impact.Issues, fmt.Sprintf("seat_mapping to_seat_id %s does not belong to the new studio", m.ToSeatID))
+			continue
+		}
+		explicit[fromID] = toID
+	}
+
+	moves := make(map[uuid.UUID]uuid.UUID, len(booked))
+	assigned := make(map[uuid.UUID]bool, len(booked))
+	for _, t := range booked {
+		fromLabel := seatLabel(oldByID[t.SeatID])
+		move := response.SeatMoveResponse{TicketID: t.ID, FromSeatID: t.SeatID, FromSeat: fromLabel}
+
+		toID, ok := explicit[t.SeatID]
+		if !ok && req.AutoMapSeats {
+			if seat, found := newByLabel[fromLabel]; found {
+				toID, ok = seat.ID, true
+			}
+		}
+
+		if !ok {
+			impact.Issues = append(impact.Issues, fmt.Sprintf("no seat in the new studio for sold seat %s", fromLabel))
+		} else if assigned[toID] {
+			impact.Issues = append(impact.Issues, fmt.Sprintf("seat %s in the new studio is assigned twice", seatLabel(newByID[toID])))
+		} else {
+			assigned[toID] = true
+			moves[t.ID] = toID
+			move.ToSeatID = &toID
+			move.ToSeat = seatLabel(newByID[toID])
+		}
+		impact.SeatMoves = append(impact.SeatMoves, move)
+	}
+
+	return moves, nil
+}
+
+func (uc *scheduleUseCase) sendScheduleChangeEmails(schedule *domain.Schedule, impact *response.ScheduleUpdateImpactResponse) {
+	transactions, err := uc.transRepo.FindBySchedule(schedule.ID, []enums.TransactionStatus{enums.TransactionPending, enums.TransactionPaid})
+	if err != nil {
+		fmt.Printf("ERROR Email: Failed to load ticket holders for schedule %s: %v\n", schedule.ID, err)
+		return
+	}
+
+	for _, trx := range transactions {
+		subject := "Perubahan Jadwal: " + schedule.Movie.Title
+		body := fmt.Sprintf(`
+            <h1>Jadwal Anda berubah</h1>
+            <p>Hi %s, ada perubahan pada jadwal <b>%s</b>.</p>
+            <p>Sebelumnya: %s</p>
+            <p>Sekarang: <b>%s</b> di %s</p>
+            <ul>%s</ul>
+            <p>Jika jadwal baru tidak sesuai, silakan hubungi kami untuk pembatalan.</p>
+        `, trx.User.Name, schedule.Movie.Title, formatLocalTime(impact.OldStartTime, &schedule.Studio),
+			formatLocalTime(schedule.StartTime, &schedule.Studio), schedule.Studio.Name, receiptTicketLines(trx.Tickets))
+
+		if err := uc.mailer.Send(trx.User.Email, subject, body); err != nil {
+			fmt.Printf("ERROR Email: Failed to send schedule change email to %s: %v\n", trx.User.Email, err)
+		}
+	}
 }
 
 func (uc *scheduleUseCase) GetByID(id uuid.UUID) (*domain.Schedule, error) {
-	return uc.scheduleRepo.FindByID(id)
+	schedule, err := uc.scheduleRepo.FindByID(id)
+	if err != nil {
+		return nil, err
+	}
+	schedules := []domain.Schedule{*schedule}
+	if err := fillSeatAvailability(uc.ticketRepo, schedules); err != nil {
+		return nil, err
+	}
+	return &schedules[0], nil
 }
 
-func (uc *scheduleUseCase) GetAll(page int, limit int) ([]domain.Schedule, *utils.PaginationMeta, error) {
-	schedules, total, err := uc.scheduleRepo.FindAll(page, limit)
+func (uc *scheduleUseCase) GetAll(req request.ScheduleFilterRequest, page int, limit int) ([]domain.Schedule, *utils.PaginationMeta, error) {
+	// Tanggal filter mengikuti zona waktu studio / cinema jika difilter per studio / cinema
+	loc := utils.LoadLocation(uc.cfg.DefaultTimezone)
+	if id, err := uuid.Parse(req.StudioID); err == nil {
+		if studio, err := uc.studioRepo.FindByID(id); err == nil {
+			loc = studio.Location()
+		}
+	} else if id, err := uuid.Parse(req.CinemaID); err == nil {
+		if cinema, err := uc.cinemaRepo.FindByID(id); err == nil {
+			loc = cinema.Location()
+		}
+	}
+
+	filter, err := buildScheduleFilter(req, time.Now(), loc)
 	if err != nil {
 		return nil, nil, err
 	}
 
+	filter.Status = enums.ScheduleActive // Listing publik tidak menampilkan jadwal yang dibatalkan
+
+	schedules, total, err := uc.scheduleRepo.FindAll(filter, page, limit)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := fillSeatAvailability(uc.ticketRepo, schedules); err != nil {
+		return nil, nil, err
+	}
+
 	totalPages := int(math.Ceil(float64(total) / float64(limit)))
 	meta := &utils.PaginationMeta{
 		CurrentPage: page,
@@ -179,10 +595,280 @@ func (uc *scheduleUseCase) GetAll(page int, limit int) ([]domain.Schedule, *util
 	return schedules, meta, nil
 }
 
+func (uc *scheduleUseCase) GetPriceHistory(id uuid.UUID) ([]domain.SchedulePriceHistory, error) {
+	if _, err := uc.scheduleRepo.FindByID(id); err != nil {
+		return nil, errors.New("schedule not found")
+	}
+	return uc.scheduleRepo.FindPriceHistory(id)
+}
+
 func (uc *scheduleUseCase) Delete(id uuid.UUID) error {
 	_, err := uc.scheduleRepo.FindByID(id)
 	if err != nil {
 		return errors.New("schedule not found")
 	}
+
+	// Jangan hapus jadwal yang sudah punya tiket, pemegang tiket harus ditangani lewat Cancel
+	booked, err := uc.ticketRepo.CountBookedSeats([]uuid.UUID{id})
+	if err != nil {
+		return err
+	}
+	if booked[id] > 0 {
+		return errors.New("schedule has sold tickets, cancel it instead")
+	}
 	return uc.scheduleRepo.Delete(id)
 }
+
+func (uc *scheduleUseCase) Cancel(id uuid.UUID, req request.CancelScheduleRequest) (*response.ScheduleCancellationResponse, error) {
+	// 1. Validasi Jadwal
+	schedule, err := uc.scheduleRepo.FindByID(id)
+	if err != nil {
+		return nil, errors.New("schedule not found")
+	}
+	if schedule.Status == enums.ScheduleCancelled {
+		return nil, errors.New("schedule is already cancelled")
+	}
+	if schedule.EndTime.Before(time.Now()) {
+		return nil, errors.New("cannot cancel a schedule that has already ended")
+	}
+
+	resolution := req.Resolution
+	if resolution == "" {
+		resolution = enums.CancellationRefund
+	}
+
+	// 2. Validasi Jadwal Pengganti (Opsional)
+	var replacement *domain.Schedule
+	if req.ReplacementScheduleID != "" {
+		replacementID, _ := uuid.Parse(req.ReplacementScheduleID)
+		if replacementID == id {
+			return nil, errors.New("replacement schedule must be a different schedule")
+		}
+		replacement, err = uc.scheduleRepo.FindByID(replacementID)
+		if err != nil {
+			return nil, errors.New("replacement schedule not found")
+		}
+		if replacement.Status == enums.ScheduleCancelled || !replacement.StartTime.After(time.Now()) {
+			return nil, errors.New("replacement schedule must be active and upcoming")
+		}
+	}
+
+	// 3. Tandai Jadwal Batal dulu agar tidak ada booking baru masuk
+	var replacementID *uuid.UUID
+	if replacement != nil {
+		replacementID = &replacement.ID
+	}
+	if err := uc.scheduleRepo.Cancel(id, req.Reason, replacementID); err != nil {
+		if errors.Is(err, repository.ErrScheduleNotActive) {
+			return nil, errors.New("schedule is already cancelled")
+		}
+		return nil, err
+	}
+
+	// 4. Tangani Transaksi Terdampak
+	transactions, err := uc.transRepo.FindBySchedule(id, []enums.TransactionStatus{enums.TransactionPending, enums.TransactionPaid})
+	if err != nil {
+		return nil, err
+	}
+
+	report := &response.ScheduleCancellationResponse{
+		ScheduleID:            id,
+		Reason:                req.Reason,
+		Resolution:            resolution,
+		ReplacementScheduleID: replacementID,
+		AffectedTransactions:  len(transactions),
+		FailedTransactionIDs:  []uuid.UUID{},
+	}
+
+	var notify []domain.Transaction
+	for _, trx := range transactions {
+		// Satu transaksi gagal tidak menghentikan yang lain, dicatat untuk ditangani manual
+		var err error
+		switch {
+		case trx.Status == enums.TransactionPending:
+			err = uc.transRepo.ReleaseTransaction(trx.ID, enums.TransactionPending, enums.TransactionCancel)
+			if err == nil {
+				report.Released++
+			}
+		case resolution == enums.CancellationCredit:
+			err = uc.transRepo.RefundToCredit(trx.ID, "Schedule cancelled: "+req.Reason)
+			if err == nil {
+				report.Credited++
+				report.CreditedAmount += trx.FinalAmount
+			}
+		default:
+			err = uc.transRepo.ReleaseTransaction(trx.ID, enums.TransactionPaid, enums.TransactionRefund)
+			if err == nil {
+				report.Refunded++
+				report.RefundedAmount += trx.FinalAmount
+			}
+		}
+		if err != nil {
+			report.FailedTransactionIDs = append(report.FailedTransactionIDs, trx.ID)
+			continue
+		}
+		notify = append(notify, trx)
+	}
+
+	// 5. Email ke pemegang tiket (async)
+	go uc.sendCancellationEmails(schedule, replacement, req.Reason, resolution, notify)
+
+	return report, nil
+}
+
+func (uc *scheduleUseCase) sendCancellationEmails(schedule, replacement *domain.Schedule, reason, resolution string, transactions []domain.Transaction) {
+	for _, trx := range transactions {
+		var compensation string
+		switch {
+		case trx.Status == enums.TransactionPending:
+			compensation = "<p>Pesanan Anda yang belum dibayar telah dibatalkan otomatis.</p>"
+		case resolution == enums.CancellationCredit:
+			compensation = fmt.Sprintf("<p>Rp %.2f telah ditambahkan ke saldo kredit akun Anda.</p>", trx.FinalAmount)
+		default:
+			compensation = fmt.Sprintf("<p>Dana Rp %.2f akan dikembalikan ke metode pembayaran Anda (%s).</p>", trx.FinalAmount, trx.PaymentMethod)
+		}
+
+		rebooking := ""
+		if replacement != nil {
+			rebooking = fmt.Sprintf("<p>Sebagai gantinya, tersedia jadwal <b>%s</b> pada <b>%s</b> di %s. Silakan pesan ulang melalui aplikasi.</p>",
+				replacement.Movie.Title, formatLocalTime(replacement.StartTime, &replacement.Studio), replacement.Studio.Name)
+		}
+
+		subject := "Jadwal Dibatalkan: " + schedule.Movie.Title
+		body := fmt.Sprintf(`
+            <h1>Mohon maaf, jadwal Anda dibatalkan</h1>
+            <p>Hi %s, jadwal <b>%s</b> pada %s dibatalkan.</p>
+            <p>Alasan: %s</p>
+            <ul>%s</ul>
+            %s
+            %s
+        `, trx.User.Name, schedule.Movie.Title, formatLocalTime(schedule.StartTime, &schedule.Studio), reason,
+			receiptTicketLines(trx.Tickets), compensation, rebooking)
+
+		if err := uc.mailer.Send(trx.User.Email, subject, body); err != nil {
+			fmt.Printf("ERROR Email: Failed to send cancellation email to %s: %v\n", trx.User.Email, err)
+		}
+	}
+}
+
+// buildScheduleFilter mengubah query params menjadi filter repository.
+// Tanggal dibaca dalam zona waktu loc; date_to inklusif (s/d akhir hari tsb).
+func buildScheduleFilter(req request.ScheduleFilterRequest, now time.Time, loc *time.Location) (repository.ScheduleFilter, error) {
+	var filter repository.ScheduleFilter
+
+	parseDate := func(field, value string) (time.Time, error) {
+		t, err := time.ParseInLocation("2006-01-02", value, loc)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("%s must use YYYY-MM-DD format", field)
+		}
+		return t, nil
+	}
+
+	if req.Date != "" {
+		if req.DateFrom != "" || req.DateTo != "" {
+			return filter, errors.New("date cannot be combined with date_from / date_to")
+		}
+		day, err := parseDate("date", req.Date)
+		if err != nil {
+			return filter, err
+		}
+		filter.StartFrom = day
+		filter.StartTo = day.AddDate(0, 0, 1)
+	}
+	if req.DateFrom != "" {
+		from, err := parseDate("date_from", req.DateFrom)
+		if err != nil {
+			return filter, err
+		}
+		filter.StartFrom = from
+	}
+	if req.DateTo != "" {
+		to, err := parseDate("date_to", req.DateTo)
+		if err != nil {
+			return filter, err
+		}
+		filter.StartTo = to.AddDate(0, 0, 1)
+	}
+	if !filter.StartFrom.IsZero() && !filter.StartTo.IsZero() && !filter.StartTo.After(filter.StartFrom) {
+		return filter, errors.New("date_to must be on or after date_from")
+	}
+
+	// Default: sembunyikan jadwal yang sudah mulai
+	if !req.IncludePast && filter.StartFrom.Before(now) {
+		filter.StartFrom = now
+	}
+
+	if req.MovieID != "" {
+		id, err := uuid.Parse(req.MovieID)
+		if err != nil {
+			return filter, errors.New("invalid movie_id")
+		}
+		filter.MovieID = id
+	}
+	if req.StudioID != "" {
+		id, err := uuid.Parse(req.StudioID)
+		if err != nil {
+			return filter, errors.New("invalid studio_id")
+		}
+		filter.StudioID = id
+	}
+	if req.CinemaID != "" {
+		id, err := uuid.Parse(req.CinemaID)
+		if err != nil {
+			return filter, errors.New("invalid cinema_id")
+		}
+		filter.CinemaID = id
+	}
+	if req.MinPrice != "" {
+		price, err := strconv.ParseFloat(req.MinPrice, 64)
+		if err != nil || price < 0 {
+			return filter, errors.New("invalid min_price")
+		}
+		filter.MinPrice = price
+	}
+	if req.MaxPrice != "" {
+		price, err := strconv.ParseFloat(req.MaxPrice, 64)
+		if err != nil || price < 0 {
+			return filter, errors.New("invalid max_price")
+		}
+		filter.MaxPrice = price
+	}
+	if filter.MaxPrice > 0 && filter.MaxPrice < filter.MinPrice {
+		return filter, errors.New("max_price must be greater than min_price")
+	}
+
+	switch format := strings.ToLower(req.Format); format {
+	case "", enums.Format2D, enums.Format3D:
+		filter.Format = format
+	default:
+		return filter, errors.New("format must be 2d or 3d")
+	}
+	filter.Feature = strings.ToLower(strings.TrimSpace(req.Feature))
+	filter.AudioLanguage = strings.TrimSpace(req.AudioLanguage)
+	filter.SubtitleLanguage = strings.TrimSpace(req.SubtitleLanguage)
+
+	return filter, nil
+}
+
+// fillSeatAvailability mengisi AvailableSeats & SoldOut berdasarkan kapasitas studio
+// dengan 1 query agregat untuk semua jadwal (Studio harus sudah di-preload).
+func fillSeatAvailability(ticketRepo repository.TicketRepository, schedules []domain.Schedule) error {
+	ids := make([]uuid.UUID, len(schedules))
+	for i := range schedules {
+		ids[i] = schedules[i].ID
+	}
+	booked, err := ticketRepo.CountBookedSeats(ids)
+	if err != nil {
+		return err
+	}
+
+	for i := range schedules {
+		available := schedules[i].Studio.Capacity - booked[schedules[i].ID]
+		if available < 0 {
+			available = 0
+		}
+		schedules[i].AvailableSeats = available
+		schedules[i].SoldOut = available == 0
+	}
+	return nil
+}
@@ -2,35 +2,69 @@ package usecase
 
 import (
 	"errors"
+	"fmt"
 	"math"
+	"movie-app/internal/config"
 	"movie-app/internal/delivery/http/dto/request"
 	"movie-app/internal/domain"
 	"movie-app/internal/repository"
 	"movie-app/pkg/utils"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 )
 
 type StudioUseCase interface {
-	Create(req request.CreateStudioRequest) (*domain.Studio, error)
-	Update(id uuid.UUID, req request.UpdateStudioRequest) (*domain.Studio, error)
+	// Create & Update: admin cabang hanya boleh memakai cinema dalam scope-nya
+	Create(scope domain.AdminScope, req request.CreateStudioRequest) (*domain.Studio, error)
+	Update(scope domain.AdminScope, id uuid.UUID, req request.UpdateStudioRequest) (*domain.Studio, error)
 	Delete(id uuid.UUID) error
 	GetByID(id uuid.UUID) (*domain.Studio, error)
-	GetAll(page int, limit int) ([]domain.Studio, *utils.PaginationMeta, error)
+	// GetAll untuk admin cabang hanya berisi studio di cinema-nya
+	GetAll(scope domain.AdminScope, page int, limit int) ([]domain.Studio, *utils.PaginationMeta, error)
+	UpdateSeatCategory(studioID uuid.UUID, req request.UpdateSeatCategoryRequest) error
 }
 
 type studioUseCase struct {
 	studioRepo repository.StudioRepository
+	cinemaRepo repository.CinemaRepository
+	cfg        *config.Config
 }
 
-func NewStudioUseCase(studioRepo repository.StudioRepository) StudioUseCase {
-	return &studioUseCase{studioRepo}
+func NewStudioUseCase(studioRepo repository.StudioRepository, cinemaRepo repository.CinemaRepository, cfg *config.Config) StudioUseCase {
+	return &studioUseCase{studioRepo, cinemaRepo, cfg}
 }
 
-func (uc *studioUseCase) Create(req request.CreateStudioRequest) (*domain.Studio, error) {
+func (uc *studioUseCase) Create(scope domain.AdminScope, req request.CreateStudioRequest) (*domain.Studio, error) {
+	if !scope.Global && req.CinemaID == "" {
+		return nil, errors.New("cinema_id is required")
+	}
+
 	studio := &domain.Studio{
-		Name:     req.Name,
-		Capacity: req.Capacity,
+		Name:            req.Name,
+		Capacity:        req.Capacity,
+		CleaningMinutes: 15,
+		Timezone:        uc.cfg.DefaultTimezone,
+		Features:        normalizeTags(req.Features),
+	}
+	if req.CleaningMinutes != nil {
+		studio.CleaningMinutes = *req.CleaningMinutes
+	}
+	// Studio di cinema mengikuti zona waktu cinema, kecuali diisi manual
+	if req.CinemaID != "" {
+		cinema, err := uc.findCinema(req.CinemaID)
+		if err != nil {
+			return nil, err
+		}
+		if !scope.AllowsCinema(&cinema.ID) {
+			return nil, ErrOutsideAdminScope
+		}
+		studio.CinemaID = &cinema.ID
+		studio.Timezone = cinema.Timezone
+	}
+	if req.Timezone != "" {
+		studio.Timezone = req.Timezone
 	}
 
 	// --- LOGIC AUTO GENERATE SEATS ---
@@ -75,7 +109,7 @@ func (uc *studioUseCase) Create(req request.CreateStudioRequest) (*domain.Studio
 	return studio, nil
 }
 
-func (uc *studioUseCase) Update(id uuid.UUID, req request.UpdateStudioRequest) (*domain.Studio, error) {
+func (uc *studioUseCase) Update(scope domain.AdminScope, id uuid.UUID, req request.UpdateStudioRequest) (*domain.Studio, error) {
 	studio, err := uc.studioRepo.FindByID(id)
 	if err != nil {
 		return nil, errors.New("studio not found")
@@ -87,6 +121,33 @@ func (uc *studioUseCase) Update(id uuid.UUID, req request.UpdateStudioRequest) (
 	if req.Capacity > 0 {
 		studio.Capacity = req.Capacity
 	}
+	if req.CleaningMinutes != nil {
+		studio.CleaningMinutes = *req.CleaningMinutes
+	}
+	if req.CinemaID != "" {
+		cinema, err := uc.findCinema(req.CinemaID)
+		if err != nil {
+			return nil, err
+		}
+		if studio.CinemaID == nil || *studio.CinemaID != cinema.ID {
+			// Memindahkan studio hanya ke cinema dalam scope admin
+			if !scope.AllowsCinema(&cinema.ID) {
+				return nil, ErrOutsideAdminScope
+			}
+			studio.CinemaID = &cinema.ID
+			studio.Timezone = cinema.Timezone
+		}
+	}
+	if req.Timezone != "" {
+		studio.Timezone = req.Timezone
+	}
+	if req.Features != nil {
+		features := normalizeTags(req.Features)
+		if err := uc.checkRemovedFeatures(studio, features); err != nil {
+			return nil, err
+		}
+		studio.Features = features
+	}
 
 	if err := uc.studioRepo.Update(studio); err != nil {
 		return nil, err
@@ -111,8 +172,8 @@ func (uc *studioUseCase) GetByID(id uuid.UUID) (*domain.Studio, error) {
 	return studio, nil
 }
 
-func (uc *studioUseCase) GetAll(page int, limit int) ([]domain.Studio, *utils.PaginationMeta, error) {
-	studios, total, err := uc.studioRepo.FindAll(page, limit)
+func (uc *studioUseCase) GetAll(scope domain.AdminScope, page int, limit int) ([]domain.Studio, *utils.PaginationMeta, error) {
+	studios, total, err := uc.studioRepo.FindAll(scope.CinemaFilter(), page, limit)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -127,3 +188,55 @@ func (uc *studioUseCase) GetAll(page int, limit int) ([]domain.Studio, *utils.Pa
 
 	return studios, meta, nil
 }
+
+func (uc *studioUseCase) UpdateSeatCategory(studioID uuid.UUID, req request.UpdateSeatCategoryRequest) error {
+	if _, err := uc.studioRepo.FindByID(studioID); err != nil {
+		return errors.New("studio not found")
+	}
+
+	seatIDs := make([]uuid.UUID, 0, len(req.SeatIDs))
+	for _, s := range req.SeatIDs {
+		id, _ := uuid.Parse(s)
+		seatIDs = append(seatIDs, id)
+	}
+
+	updated, err := uc.studioRepo.UpdateSeatCategory(studioID, seatIDs, req.Category)
+	if err != nil {
+		return err
+	}
+	if updated != int64(len(seatIDs)) {
+		return errors.New("some seats do not belong to this studio")
+	}
+	return nil
+}
+
+func (uc *studioUseCase) findCinema(idStr string) (*domain.Cinema, error) {
+	id, _ := uuid.Parse(idStr)
+	cinema, err := uc.cinemaRepo.FindByID(id)
+	if err != nil {
+		return nil, errors.New("cinema not found")
+	}
+	return cinema, nil
+}
+
+// checkRemovedFeatures menolak penghapusan fitur yang masih dipakai jadwal mendatang (mis. 3D)
+func (uc *studioUseCase) checkRemovedFeatures(studio *domain.Studio, features []string) error {
+	var removed []string
+	for _, f := range studio.Features {
+		if !containsFold(features, f) {
+			removed = append(removed, f)
+		}
+	}
+	if len(removed) == 0 {
+		return nil
+	}
+
+	count, err := uc.studioRepo.CountUpcomingByFeatures(studio.ID, removed, time.Now())
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return fmt.Errorf("%d upcoming schedules still use %s", count, strings.Join(removed, ", "))
+	}
+	return nil
+}
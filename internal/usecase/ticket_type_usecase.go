@@ -0,0 +1,136 @@
+package usecase
+
+import (
+	"errors"
+	"math"
+	"movie-app/internal/delivery/http/dto/request"
+	"movie-app/internal/domain"
+	"movie-app/internal/enums"
+	"movie-app/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+type TicketTypeUseCase interface {
+	Create(req request.CreateTicketTypeRequest) (*domain.TicketType, error)
+	Update(id uuid.UUID, req request.UpdateTicketTypeRequest) (*domain.TicketType, error)
+	Delete(id uuid.UUID) error
+	GetAll() ([]domain.TicketType, error)
+	GetActive() ([]domain.TicketType, error)
+}
+
+type ticketTypeUseCase struct {
+	ticketTypeRepo repository.TicketTypeRepository
+}
+
+func NewTicketTypeUseCase(ticketTypeRepo repository.TicketTypeRepository) TicketTypeUseCase {
+	return &ticketTypeUseCase{ticketTypeRepo}
+}
+
+func (uc *ticketTypeUseCase) Create(req request.CreateTicketTypeRequest) (*domain.TicketType, error) {
+	if _, err := uc.ticketTypeRepo.FindByCode(req.Code); err == nil {
+		return nil, errors.New("ticket type code already exists")
+	}
+
+	ticketType := &domain.TicketType{
+		Code:            req.Code,
+		Name:            req.Name,
+		Description:     req.Description,
+		AdjustmentType:  req.AdjustmentType,
+		AdjustmentValue: req.AdjustmentValue,
+		Requirement:     req.Requirement,
+		IsActive:        true,
+		SortOrder:       req.SortOrder,
+	}
+	if req.IsActive != nil {
+		ticketType.IsActive = *req.IsActive
+	}
+
+	if err := validateTicketType(ticketType); err != nil {
+		return nil, err
+	}
+
+	if err := uc.ticketTypeRepo.Create(ticketType); err != nil {
+		return nil, err
+	}
+	return ticketType, nil
+}
+
+func (uc *ticketTypeUseCase) Update(id uuid.UUID, req request.UpdateTicketTypeRequest) (*domain.TicketType, error) {
+	ticketType, err := uc.ticketTypeRepo.FindByID(id)
+	if err != nil {
+		return nil, errors.New("ticket type not found")
+	}
+
+	if req.Name != "" {
+		ticketType.Name = req.Name
+	}
+	if req.Description != nil {
+		ticketType.Description = *req.Description
+	}
+	if req.AdjustmentType != "" {
+		ticketType.AdjustmentType = req.AdjustmentType
+	}
+	if req.AdjustmentValue != nil {
+		ticketType.AdjustmentValue = *req.AdjustmentValue
+	}
+	if req.Requirement != nil {
+		ticketType.Requirement = *req.Requirement
+	}
+	if req.IsActive != nil {
+		ticketType.IsActive = *req.IsActive
+	}
+	if req.SortOrder != nil {
+		ticketType.SortOrder = *req.SortOrder
+	}
+
+	if err := validateTicketType(ticketType); err != nil {
+		return nil, err
+	}
+
+	if err := uc.ticketTypeRepo.Update(ticketType); err != nil {
+		return nil, err
+	}
+	return ticketType, nil
+}
+
+func (uc *ticketTypeUseCase) Delete(id uuid.UUID) error {
+	ticketType, err := uc.ticketTypeRepo.FindByID(id)
+	if err != nil {
+		return errors.New("ticket type not found")
+	}
+	if ticketType.Code == enums.TicketTypeDefault {
+		return errors.New("default ticket type cannot be deleted")
+	}
+	return uc.ticketTypeRepo.Delete(id)
+}
+
+func (uc *ticketTypeUseCase) GetAll() ([]domain.TicketType, error) {
+	return uc.ticketTypeRepo.FindAll()
+}
+
+func (uc *ticketTypeUseCase) GetActive() ([]domain.TicketType, error) {
+	return uc.ticketTypeRepo.FindActive()
+}
+
+func validateTicketType(ticketType *domain.TicketType) error {
+	if ticketType.AdjustmentType == enums.AdjustmentTypePercentage && ticketType.AdjustmentValue <= -100 {
+		return errors.New("percentage adjustment must be greater than -100")
+	}
+	return nil
+}
+
+// calculateTicketTypeAdjustment mengembalikan perubahan harga kursi untuk tipe tiket tsb.
+// Harga akhir tidak pernah di bawah 0.
+func calculateTicketTypeAdjustment(ticketType *domain.TicketType, price float64) float64 {
+	var amount float64
+	if ticketType.AdjustmentType == enums.AdjustmentTypePercentage {
+		amount = math.Round(price * ticketType.AdjustmentValue / 100)
+	} else {
+		amount = ticketType.AdjustmentValue
+	}
+	if price+amount < 0 {
+		amount = -price
+	}
+	return amount
+}
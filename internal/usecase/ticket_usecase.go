@@ -2,6 +2,8 @@ package usecase
 
 import (
 	"errors"
+	"fmt"
+	"movie-app/internal/config"
 	"movie-app/internal/delivery/http/dto/request"
 	"movie-app/internal/delivery/http/dto/response"
 	"movie-app/internal/domain"
@@ -13,16 +15,25 @@ import (
 )
 
 type TicketUseCase interface {
-	GetAvailableSeats(scheduleID uuid.UUID) ([]response.SeatAvailabilityResponse, error)
+	// GetAvailableSeats mengembalikan denah kursi & apakah jadwal sedang bisa dijual (beserta alasannya)
+	GetAvailableSeats(scheduleID uuid.UUID) (*response.ScheduleSeatsResponse, error)
 	BookTicket(userID uuid.UUID, req request.BookTicketRequest) (*domain.Transaction, error)
+	// QuoteBooking menghitung rincian harga tanpa membuat transaksi
+	QuoteBooking(userID uuid.UUID, req request.BookTicketRequest) (*response.QuoteResponse, error)
 	GetUserHistory(userID uuid.UUID) ([]domain.Transaction, error)
 }
 
 type ticketUseCase struct {
-	ticketRepo   repository.TicketRepository
-	scheduleRepo repository.ScheduleRepository
-	studioRepo   repository.StudioRepository
-	promoRepo    repository.PromoRepository
+	ticketRepo     repository.TicketRepository
+	scheduleRepo   repository.ScheduleRepository
+	studioRepo     repository.StudioRepository
+	promoRepo      repository.PromoRepository
+	methodRepo     repository.PaymentMethodRepository
+	campaignRepo   repository.CampaignRepository
+	pricingRepo    repository.PricingRuleRepository
+	ticketTypeRepo repository.TicketTypeRepository
+	calendarRepo   repository.CalendarRepository
+	cfg            *config.Config
 }
 
 func NewTicketUseCase(
@@ -30,18 +41,29 @@ func NewTicketUseCase(
 	sRepo repository.ScheduleRepository,
 	stRepo repository.StudioRepository,
 	pRepo repository.PromoRepository,
+	pmRepo repository.PaymentMethodRepository,
+	cRepo repository.CampaignRepository,
+	prRepo repository.PricingRuleRepository,
+	ttRepo repository.TicketTypeRepository,
+	calRepo repository.CalendarRepository,
+	cfg *config.Config,
 ) TicketUseCase {
 	// FIX 1: Masukkan pRepo ke struct return
 	return &ticketUseCase{
-		ticketRepo:   tRepo,
-		scheduleRepo: sRepo,
-		studioRepo:   stRepo,
-		promoRepo:    pRepo,
+		ticketRepo:     tRepo,
+		scheduleRepo:   sRepo,
+		studioRepo:     stRepo,
+		promoRepo:      pRepo,
+		methodRepo:     pmRepo,
+		campaignRepo:   cRepo,
+		pricingRepo:    prRepo,
+		ticketTypeRepo: ttRepo,
+		calendarRepo:   calRepo,
+		cfg:            cfg,
 	}
 }
 
-func (uc *ticketUseCase) GetAvailableSeats(scheduleID uuid.UUID) ([]response.SeatAvailabilityResponse, error) {
-	// ... (Logika GetAvailableSeats tidak berubah, copy dari sebelumnya) ...
+func (uc *ticketUseCase) GetAvailableSeats(scheduleID uuid.UUID) (*response.ScheduleSeatsResponse, error) {
 	schedule, err := uc.scheduleRepo.FindByID(scheduleID)
 	if err != nil {
 		return nil, errors.New("schedule not found")
@@ -62,91 +84,368 @@ func (uc *ticketUseCase) GetAvailableSeats(scheduleID uuid.UUID) ([]response.Sea
 		bookedMap[t.SeatID] = true
 	}
 
-	var result []response.SeatAvailabilityResponse
+	result := &response.ScheduleSeatsResponse{ScheduleID: schedule.ID, Seats: []response.SeatAvailabilityResponse{}}
+	free := 0
 	for _, seat := range allSeats {
 		isBooked := bookedMap[seat.ID]
-		result = append(result, response.SeatAvailabilityResponse{
+		if !isBooked {
+			free++
+		}
+		result.Seats = append(result.Seats, response.SeatAvailabilityResponse{
 			ID:         seat.ID,
 			RowCode:    seat.RowCode,
 			SeatNumber: seat.SeatNumber,
+			Category:   seat.Category,
 			IsBooked:   isBooked,
 		})
 	}
 
+	// Status penjualan (jendela penjualan dalam jam lokal studio)
+	loc := schedule.Studio.Location()
+	openAt, closeAt := scheduleSalesWindow(schedule, uc.cfg)
+	if !openAt.IsZero() {
+		openLocal := openAt.In(loc)
+		result.SalesOpenAt = &openLocal
+	}
+	result.SalesCloseAt = closeAt.In(loc)
+	result.Reason = scheduleNotSellableReason(schedule, uc.cfg, time.Now())
+	if result.Reason == "" && free == 0 {
+		result.Reason = enums.SalesSoldOut
+	}
+	result.Sellable = result.Reason == ""
+
 	return result, nil
 }
 
+func (uc *ticketUseCase) QuoteBooking(userID uuid.UUID, req request.BookTicketRequest) (*response.QuoteResponse, error) {
+	quote, err := uc.calculateQuote(userID, req)
+	if err != nil {
+		return nil, err
+	}
+	return &quote.Response, nil
+}
+
 func (uc *ticketUseCase) BookTicket(userID uuid.UUID, req request.BookTicketRequest) (*domain.Transaction, error) {
-	// 1. Validasi Jadwal
-	scheduleID, _ := uuid.Parse(req.ScheduleID)
-	schedule, err := uc.scheduleRepo.FindByID(scheduleID)
+	// 1. Hitung harga (logic yang sama persis dengan endpoint quote)
+	quote, err := uc.calculateQuote(userID, req)
 	if err != nil {
-		return nil, errors.New("schedule not found")
+		return nil, err
 	}
 
-	// 2. Hitung Harga Dasar
-	totalAmount := schedule.Price * float64(len(req.SeatIDs))
+	// Saat booking, promo / metode pembayaran yang ditolak membatalkan booking
+	if quote.PromoErr != nil {
+		return nil, quote.PromoErr
+	}
+	if quote.PaymentErr != nil {
+		return nil, quote.PaymentErr
+	}
 
 	// FIX 2: Jangan buat transaction struct dulu. Buat slice tiket dulu.
 	var tickets []domain.Ticket
 
-	// 3. Siapkan Tiket ke dalam Slice
-	for _, seatIDStr := range req.SeatIDs {
-		seatID, _ := uuid.Parse(seatIDStr)
+	// 2. Siapkan Tiket ke dalam Slice (harga per kursi dikunci dari quote)
+	for i, seat := range quote.Seats {
 		ticket := domain.Ticket{
-			ScheduleID: scheduleID,
-			SeatID:     seatID,
+			ScheduleID:     quote.Schedule.ID,
+			SeatID:         seat.ID,
+			Price:          quote.Response.Seats[i].Price,
+			TicketTypeCode: quote.Response.Seats[i].TicketType,
+		}
+		if quote.TicketTypes[i] != nil {
+			ticket.TicketTypeID = &quote.TicketTypes[i].ID
 		}
 		tickets = append(tickets, ticket)
 	}
 
-	// 4. Logic Promo
-	var discountAmount float64 = 0
 	var promoID *uuid.UUID = nil
+	if quote.Promo != nil {
+		promoID = &quote.Promo.ID
+	}
+
+	// 3. Build Transaction Struct (SEKALI SAJA DI SINI)
+	transaction := &domain.Transaction{
+		UserID:         userID,
+		TotalAmount:    quote.Response.Subtotal,       // Harga Asli
+		DiscountAmount: quote.Response.DiscountAmount, // Potongan
+		FinalAmount:    quote.Response.FinalAmount,    // Harga Akhir (termasuk biaya pembayaran)
+		PromoID:        promoID,
+		VoucherCodeID:  quote.VoucherCodeID,
+		Status:         enums.TransactionPending,
+		PaymentMethod:  req.PaymentMethod,
+		PaymentFee:     quote.Response.FeeAmount,
+		SalesChannel:   quote.SalesChannel,
+		ExpiresAt:      time.Now().Add(quote.Expiry),
+		Tickets:        tickets, // Masukkan slice tiket yang sudah dibuat
+	}
+
+	// 4. Simpan (Atomic Transaction)
+	if err := uc.ticketRepo.CreateBooking(transaction); err != nil {
+		// Kuota promo habis dicek atomic di repository
+		if errors.Is(err, repository.ErrPromoUsageLimitReached) || errors.Is(err, repository.ErrPromoUserLimitReached) ||
+			errors.Is(err, repository.ErrVoucherAlreadyUsed) || errors.Is(err, repository.ErrBookingScheduleChanged) {
+			return nil, err
+		}
+		return nil, errors.New("some seats are already booked")
+	}
+
+	// 5. Catat harga efektif jika berubah sejak catatan terakhir (riwayat, tidak menggagalkan booking).
+	// Hanya saat booking berhasil, agar quote tetap read-only.
+	for category, price := range quote.CategoryPrices {
+		logPriceHistoryError(quote.Schedule.ID, recordSchedulePrice(uc.scheduleRepo, quote.Schedule.ID, category, price, enums.PriceChangeDynamic, quote.Occupancy))
+	}
+
+	return transaction, nil
+}
+
+// bookingQuote adalah hasil perhitungan harga yang dipakai bersama oleh QuoteBooking & BookTicket,
+// supaya harga yang dilihat user sebelum booking tidak bisa berbeda dengan harga saat booking.
+type bookingQuote struct {
+	Response      response.QuoteResponse
+	Schedule      *domain.Schedule
+	Seats         []domain.Seat
+	TicketTypes   []*domain.TicketType // Sejajar dengan Seats, nil = harga normal
+	Promo         *domain.Promo        // nil jika tidak ada promo yang berlaku
+	VoucherCodeID *uuid.UUID
+	PromoErr      error // Alasan promo ditolak
+	PaymentErr    error // Alasan metode pembayaran ditolak
+	SalesChannel  string
+	Expiry        time.Duration
+	Occupancy     float64  // Okupansi jadwal saat harga dihitung
+	DayTypes      []string // Jenis hari jadwal dari kalender
+	// Harga efektif per kategori kursi sebelum modifier tipe tiket (untuk riwayat harga)
+	CategoryPrices map[string]float64
+}
+
+// calculateQuote hanya mengembalikan error untuk input yang tidak bisa dihitung sama sekali
+// (jadwal / kursi tidak valid). Promo & metode pembayaran yang ditolak dicatat beserta alasannya.
+func (uc *ticketUseCase) calculateQuote(userID uuid.UUID, req request.BookTicketRequest) (*bookingQuote, error) {
+	// 1. Validasi Jadwal
+	scheduleID, _ := uuid.Parse(req.ScheduleID)
+	schedule, err := uc.scheduleRepo.FindByID(scheduleID)
+	if err != nil {
+		return nil, errors.New("schedule not found")
+	}
+	switch scheduleNotSellableReason(schedule, uc.cfg, time.Now()) {
+	case enums.SalesCancelled:
+		return nil, errors.New("schedule has been cancelled")
+	case enums.SalesNotOpen:
+		openAt, _ := scheduleSalesWindow(schedule, uc.cfg)
+		return nil, fmt.Errorf("tickets for this schedule go on sale at %s", formatLocalTime(openAt, &schedule.Studio))
+	case enums.SalesClosed:
+		return nil, errors.New("booking for this schedule has closed")
+	}
+
+	// 2. Validasi Kursi (harus milik studio jadwal ini, tidak boleh duplikat)
+	// seat_ids lama tetap didukung: semua kursi dianggap tipe default (adult)
+	items := req.Items
+	if len(items) == 0 {
+		for _, seatIDStr := range req.SeatIDs {
+			items = append(items, request.BookingItemRequest{SeatID: seatIDStr})
+		}
+	}
+	if len(items) == 0 {
+		return nil, errors.New("at least one seat is required")
+	}
+
+	seatIDs := make([]uuid.UUID, 0, len(items))
+	typeCodeBySeat := make(map[uuid.UUID]string)
+	for _, item := range items {
+		seatID, _ := uuid.Parse(item.SeatID)
+		if _, exists := typeCodeBySeat[seatID]; exists {
+			return nil, errors.New("duplicate seat in request")
+		}
+		typeCode := item.TicketType
+		if typeCode == "" {
+			typeCode = enums.TicketTypeDefault
+		}
+		typeCodeBySeat[seatID] = typeCode
+		seatIDs = append(seatIDs, seatID)
+	}
+	seats, err := uc.studioRepo.FindSeatsByIDs(schedule.StudioID, seatIDs)
+	if err != nil {
+		return nil, err
+	}
+	if len(seats) != len(seatIDs) {
+		return nil, errors.New("some seats do not belong to this schedule's studio")
+	}
+
+	// 2b. Validasi Tipe Tiket
+	ticketTypes, err := uc.ticketTypeRepo.FindActive()
+	if err != nil {
+		return nil, err
+	}
+	typesByCode := make(map[string]*domain.TicketType)
+	for i := range ticketTypes {
+		typesByCode[ticketTypes[i].Code] = &ticketTypes[i]
+	}
 
+	quote := &bookingQuote{Schedule: schedule, Seats: seats, TicketTypes: make([]*domain.TicketType, len(seats))}
+	for i, seat := range seats {
+		typeCode := typeCodeBySeat[seat.ID]
+		ticketType, ok := typesByCode[typeCode]
+		if !ok && typeCode != enums.TicketTypeDefault {
+			return nil, fmt.Errorf("ticket type %s is not available", typeCode)
+		}
+		// Jika tipe default dihapus admin, kursi tetap dijual dengan harga normal
+		quote.TicketTypes[i] = ticketType
+	}
+	res := &quote.Response
+	res.ScheduleID = schedule.ID
+	res.Discounts = []response.QuoteDiscountResponse{}
+	res.Fees = []response.QuoteFeeResponse{}
+
+	// 3. Harga per kursi (harga dasar jadwal + pricing rules, termasuk okupansi saat ini)
+	rules, err := uc.pricingRepo.FindActive()
+	if err != nil {
+		return nil, err
+	}
+	quote.Occupancy, err = scheduleOccupancy(uc.ticketRepo, schedule)
+	if err != nil {
+		return nil, err
+	}
+	quote.DayTypes, err = calendarDayTypes(uc.calendarRepo, scheduleLocalStart(schedule))
+	if err != nil {
+		return nil, err
+	}
+	pricingCtx := pricingContext{Occupancy: quote.Occupancy, Now: time.Now(), DayTypes: quote.DayTypes}
+	quote.CategoryPrices = make(map[string]float64)
+	for i, seat := range seats {
+		price, applied := calculateSeatPrice(rules, schedule, seat.Category, pricingCtx)
+		quote.CategoryPrices[seat.Category] = price
+		seatRes := response.QuoteSeatResponse{
+			SeatID:       seat.ID,
+			RowCode:      seat.RowCode,
+			SeatNumber:   seat.SeatNumber,
+			Category:     seat.Category,
+			BasePrice:    schedule.Price,
+			AppliedRules: applied,
+			TicketType:   enums.TicketTypeDefault,
+		}
+
+		// Modifier tipe tiket dihitung setelah pricing rules & batas harga jadwal
+		if ticketType := quote.TicketTypes[i]; ticketType != nil {
+			seatRes.TicketType = ticketType.Code
+			seatRes.TicketTypeName = ticketType.Name
+			seatRes.Requirement = ticketType.Requirement
+			seatRes.TicketTypeAmount = calculateTicketTypeAdjustment(ticketType, price)
+			price += seatRes.TicketTypeAmount
+		}
+
+		seatRes.Price = price
+		res.Seats = append(res.Seats, seatRes)
+		res.Subtotal += price
+	}
+
+	// 4. Logic Promo
 	if req.PromoCode != "" {
-		promo, err := uc.promoRepo.FindByCode(req.PromoCode)
+		discount, err := uc.applyPromo(userID, req.PromoCode, quote)
 		if err != nil {
-			return nil, errors.New("promo code invalid or expired")
+			quote.PromoErr = err
+			res.Discounts = append(res.Discounts, response.QuoteDiscountResponse{Code: req.PromoCode, Applied: false, Reason: err.Error()})
+		} else {
+			res.Discounts = append(res.Discounts, response.QuoteDiscountResponse{Code: req.PromoCode, Applied: true, Amount: discount})
+			res.DiscountAmount = discount
 		}
+	}
+	amountAfterDiscount := res.Subtotal - res.DiscountAmount
 
-		promoID = &promo.ID
+	// 5. Batas waktu pembayaran bisa berbeda per sales channel & metode pembayaran (lihat Config.PaymentExpiry)
+	quote.SalesChannel = req.SalesChannel
+	if quote.SalesChannel == "" {
+		quote.SalesChannel = enums.SalesChannelWeb
+	}
+	methodExpiryMinutes := 0
 
-		if promo.DiscountType == enums.DiscountTypePercentage {
-			discountAmount = totalAmount * (promo.DiscountValue / 100)
+	// 6. Jika user sudah memilih metode pembayaran, validasi & hitung biayanya sekarang
+	if req.PaymentMethod != "" {
+		method, err := uc.methodRepo.FindByCode(req.PaymentMethod)
+		if err != nil {
+			quote.PaymentErr = errors.New("payment method not found")
+		} else if err := checkPaymentMethod(method, amountAfterDiscount, schedule.Studio.CinemaID); err != nil {
+			quote.PaymentErr = err
 		} else {
-			discountAmount = promo.DiscountValue
+			fee := calculatePaymentFee(method, amountAfterDiscount)
+			res.Fees = append(res.Fees, response.QuoteFeeResponse{Name: method.DisplayName + " fee", Amount: fee})
+			res.FeeAmount = fee
+			methodExpiryMinutes = method.ExpiryMinutes
 		}
+		if quote.PaymentErr != nil {
+			res.PaymentMethodError = quote.PaymentErr.Error()
+		}
+	}
+	quote.Expiry = uc.cfg.PaymentExpiry(quote.SalesChannel, methodExpiryMinutes)
+
+	res.FinalAmount = amountAfterDiscount + res.FeeAmount
+	return quote, nil
+}
+
+// applyPromo memvalidasi kode promo / voucher dan mengembalikan nominal diskonnya
+func (uc *ticketUseCase) applyPromo(userID uuid.UUID, code string, quote *bookingQuote) (float64, error) {
+	promo, voucherID, err := uc.resolvePromoCode(code)
+	if err != nil {
+		return 0, err
+	}
 
-		if discountAmount > totalAmount {
-			discountAmount = totalAmount
+	// Aturan targeting (film, genre, studio, hari, jam, kategori kursi, pembeli pertama)
+	isFirstTimeBuyer := true
+	if promo.FirstTimeOnly {
+		hasPaid, err := uc.ticketRepo.HasPaidTransaction(userID)
+		if err != nil {
+			return 0, err
 		}
+		isFirstTimeBuyer = !hasPaid
+	}
+	if err := checkPromoEligibility(promo, quote.Schedule, quote.Seats, isFirstTimeBuyer, quote.DayTypes); err != nil {
+		return 0, err
 	}
-	finalAmount := totalAmount - discountAmount
 
-	// 5. Build Transaction Struct (SEKALI SAJA DI SINI)
-	transaction := &domain.Transaction{
-		UserID:         userID,
-		TotalAmount:    totalAmount,    // Harga Asli
-		DiscountAmount: discountAmount, // Potongan
-		FinalAmount:    finalAmount,    // Harga Akhir
-		PromoID:        promoID,
-		Status:         enums.TransactionPending,
-		PaymentMethod:  "",
-		Tickets:        tickets, // Masukkan slice tiket yang sudah dibuat
+	totalAmount := quote.Response.Subtotal
+
+	// Minimum belanja
+	if totalAmount < promo.MinTransactionAmount {
+		return 0, fmt.Errorf("minimum transaction amount for this promo is %.2f", promo.MinTransactionAmount)
 	}
 
-	// 6. Simpan (Atomic Transaction)
-	if err := uc.ticketRepo.CreateBooking(transaction); err != nil {
-		return nil, errors.New("some seats are already booked")
+	var discountAmount float64
+	if promo.DiscountType == enums.DiscountTypePercentage {
+		discountAmount = totalAmount * (promo.DiscountValue / 100)
+		// Cap diskon untuk promo persentase
+		if promo.MaxDiscountAmount > 0 && discountAmount > promo.MaxDiscountAmount {
+			discountAmount = promo.MaxDiscountAmount
+		}
+	} else {
+		discountAmount = promo.DiscountValue
 	}
 
-	// Set ExpiresAt untuk Response
-	transaction.ExpiresAt = transaction.CreatedAt.Add(15 * time.Minute)
+	if discountAmount > totalAmount {
+		discountAmount = totalAmount
+	}
 
-	return transaction, nil
+	quote.Promo = promo
+	quote.VoucherCodeID = voucherID
+	return discountAmount, nil
 }
 
 func (uc *ticketUseCase) GetUserHistory(userID uuid.UUID) ([]domain.Transaction, error) {
 	return uc.ticketRepo.GetByUserID(userID)
 }
+
+// resolvePromoCode mencari kode di promo biasa dulu, lalu di voucher campaign (sekali pakai).
+// Untuk voucher, promo yang dipakai adalah definisi promo milik campaign-nya.
+func (uc *ticketUseCase) resolvePromoCode(code string) (*domain.Promo, *uuid.UUID, error) {
+	if promo, err := uc.promoRepo.FindByCode(code); err == nil {
+		return promo, nil, nil
+	}
+
+	voucher, err := uc.campaignRepo.FindRedeemableCode(code)
+	if err != nil {
+		return nil, nil, errors.New("promo code invalid or expired")
+	}
+
+	promo := voucher.Campaign.Promo
+	now := time.Now()
+	if now.Before(promo.ValidFrom) || !now.Before(promo.ValidUntil) {
+		return nil, nil, errors.New("promo code invalid or expired")
+	}
+	return &promo, &voucher.ID, nil
+}
@@ -8,6 +8,7 @@ import (
 	"movie-app/internal/enums"
 	"movie-app/internal/repository"
 	"movie-app/pkg/mailer"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -16,18 +17,21 @@ import (
 type TransactionUseCase interface {
 	PayTransaction(userID uuid.UUID, transactionID uuid.UUID, req request.PayTransactionRequest) error
 	CancelTransaction(userID uuid.UUID, transactionID uuid.UUID) error
+	RefundTransaction(transactionID uuid.UUID) error
 	AutoCancelExpiredTransactions() error
+	ExtendExpiry(transactionID uuid.UUID, minutes int) (*domain.Transaction, error)
 	GetUserTransactions(userID uuid.UUID) ([]domain.Transaction, error)
 	SendUpcomingScheduleReminders() error
 }
 
 type transactionUseCase struct {
-	transRepo repository.TransactionRepository
-	mailer    *mailer.Mailer
+	transRepo  repository.TransactionRepository
+	methodRepo repository.PaymentMethodRepository
+	mailer     *mailer.Mailer
 }
 
-func NewTransactionUseCase(transRepo repository.TransactionRepository, mailer *mailer.Mailer) TransactionUseCase {
-	return &transactionUseCase{transRepo, mailer}
+func NewTransactionUseCase(transRepo repository.TransactionRepository, methodRepo repository.PaymentMethodRepository, mailer *mailer.Mailer) TransactionUseCase {
+	return &transactionUseCase{transRepo, methodRepo, mailer}
 }
 
 func (uc *transactionUseCase) PayTransaction(userID uuid.UUID, transactionID uuid.UUID, req request.PayTransactionRequest) error {
@@ -47,7 +51,25 @@ func (uc *transactionUseCase) PayTransaction(userID uuid.UUID, transactionID uui
 		return errors.New("transaction is not pending (already paid or cancelled)")
 	}
 
-	if err := uc.transRepo.UpdateStatus(transactionID, enums.TransactionPaid, req.PaymentMethod); err != nil {
+	// 4. Validasi Batas Waktu Pembayaran
+	if time.Now().After(transaction.ExpiresAt) {
+		return errors.New("transaction has expired")
+	}
+
+	// 5. Validasi Metode Pembayaran (data-driven dari katalog payment_methods)
+	method, err := uc.methodRepo.FindByCode(req.PaymentMethod)
+	if err != nil {
+		return errors.New("payment method not found")
+	}
+
+	// Biaya dihitung ulang dari harga sebelum fee, karena metode bisa beda dari yang dipilih saat booking
+	amount := transaction.FinalAmount - transaction.PaymentFee
+	if err := checkPaymentMethod(method, amount, transactionCinemaID(transaction)); err != nil {
+		return err
+	}
+	fee := calculatePaymentFee(method, amount)
+
+	if err := uc.transRepo.MarkAsPaid(transactionID, method.Code, fee, amount+fee); err != nil {
 		return err
 	}
 
@@ -64,8 +86,9 @@ func (uc *transactionUseCase) PayTransaction(userID uuid.UUID, transactionID uui
             <h1>Payment Successful</h1>
             <p>Hi %s, terima kasih sudah memesan tiket.</p>
             <p>Film: <b>%s</b></p>
+            <ul>%s</ul>
             <p>Total: Rp %.2f</p>
-        `, trx.User.Name, trx.Tickets[0].Schedule.Movie.Title, trx.FinalAmount)
+        `, trx.User.Name, trx.Tickets[0].Schedule.Movie.Title, receiptTicketLines(trx.Tickets), trx.FinalAmount)
 
 		// Cek Error Send
 		if err := uc.mailer.Send(trx.User.Email, subject, body); err != nil {
@@ -96,9 +119,23 @@ func (uc *transactionUseCase) CancelTransaction(userID uuid.UUID, transactionID
 		return errors.New("cannot cancel transaction (already paid or cancelled)")
 	}
 
-	// D. Update Status jadi CANCELLED
-	// Payment method dikosongkan atau biarkan string kosong
-	return uc.transRepo.UpdateStatus(transactionID, enums.TransactionCancel, "")
+	// D. Update Status jadi CANCELLED + kembalikan kuota promo / voucher
+	return uc.transRepo.ReleaseTransaction(transactionID, enums.TransactionPending, enums.TransactionCancel)
+}
+
+func (uc *transactionUseCase) RefundTransaction(transactionID uuid.UUID) error {
+	transaction, err := uc.transRepo.FindByID(transactionID)
+	if err != nil {
+		return errors.New("transaction not found")
+	}
+
+	// Hanya transaksi yang sudah dibayar yang bisa di-refund
+	if transaction.Status != enums.TransactionPaid {
+		return errors.New("only paid transactions can be refunded")
+	}
+
+	// Kursi dilepas (tickets.released_at) & kuota promo dikembalikan
+	return uc.transRepo.ReleaseTransaction(transactionID, enums.TransactionPaid, enums.TransactionRefund)
 }
 
 func (uc *transactionUseCase) GetUserTransactions(userID uuid.UUID) ([]domain.Transaction, error) {
@@ -106,20 +143,17 @@ func (uc *transactionUseCase) GetUserTransactions(userID uuid.UUID) ([]domain.Tr
 }
 
 func (uc *transactionUseCase) AutoCancelExpiredTransactions() error {
-	// 1. Tentukan batas waktu (Misal: 15 Menit yang lalu)
-	expiryTime := time.Now().Add(-15 * time.Minute)
-
-	// 2. Cari transaksi yang bandel (belum bayar lewat dari 15 menit)
-	expiredTransactions, err := uc.transRepo.GetExpiredPendingTransactions(expiryTime)
+	// 1. Cari transaksi yang bandel (belum bayar melewati expires_at masing-masing)
+	expiredTransactions, err := uc.transRepo.GetExpiredPendingTransactions(time.Now())
 	if err != nil {
 		return err
 	}
 
-	// 3. Loop dan Cancel satu per satu
+	// 2. Loop dan Cancel satu per satu
 	for _, tx := range expiredTransactions {
-		// Update status ke Cancelled
+		// Update status ke Cancelled + kembalikan kuota promo / voucher
 		// Kita abaikan error per item agar satu gagal tidak menghentikan yang lain
-		_ = uc.transRepo.UpdateStatus(tx.ID, enums.TransactionCancel, "")
+		_ = uc.transRepo.ReleaseTransaction(tx.ID, enums.TransactionPending, enums.TransactionCancel)
 
 		// (Optional) Log ke terminal
 		// fmt.Printf("Auto-cancelling transaction: %s\n", tx.ID)
@@ -128,6 +162,32 @@ func (uc *transactionUseCase) AutoCancelExpiredTransactions() error {
 	return nil
 }
 
+func (uc *transactionUseCase) ExtendExpiry(transactionID uuid.UUID, minutes int) (*domain.Transaction, error) {
+	transaction, err := uc.transRepo.FindByID(transactionID)
+	if err != nil {
+		return nil, errors.New("transaction not found")
+	}
+
+	// Hanya transaksi pending yang masih bisa diperpanjang
+	if transaction.Status != enums.TransactionPending {
+		return nil, errors.New("only pending transactions can be extended")
+	}
+
+	// Kalau sudah lewat, hitung perpanjangan dari sekarang (bukan dari deadline lama)
+	base := transaction.ExpiresAt
+	if now := time.Now(); base.Before(now) {
+		base = now
+	}
+	newExpiresAt := base.Add(time.Duration(minutes) * time.Minute)
+
+	if err := uc.transRepo.UpdateExpiresAt(transactionID, newExpiresAt); err != nil {
+		return nil, err
+	}
+
+	transaction.ExpiresAt = newExpiresAt
+	return transaction, nil
+}
+
 func (uc *transactionUseCase) SendUpcomingScheduleReminders() error {
 	// Range waktu: film yang mulai 1 jam dari sekarang s/d 2 jam dari sekarang
 	now := time.Now()
@@ -141,12 +201,13 @@ func (uc *transactionUseCase) SendUpcomingScheduleReminders() error {
 
 	for _, trx := range transactions {
 		// Kirim Email
+		schedule := trx.Tickets[0].Schedule
 		subject := "Reminder: Film Anda Segera Mulai!"
 		body := fmt.Sprintf(`
             <h1>Siap-siap nonton!</h1>
-            <p>Hi %s, film <b>%s</b> akan dimulai dalam 1 jam lagi.</p>
+            <p>Hi %s, film <b>%s</b> akan dimulai pukul <b>%s</b> di %s.</p>
             <p>Segera datang ke bioskop ya!</p>
-        `, trx.User.Name, trx.Tickets[0].Schedule.Movie.Title)
+        `, trx.User.Name, schedule.Movie.Title, formatLocalTime(schedule.StartTime, &schedule.Studio), schedule.Studio.Name)
 
 		if err := uc.mailer.Send(trx.User.Email, subject, body); err == nil {
 			// Jika sukses kirim, tandai di DB agar tidak kirim lagi
@@ -155,3 +216,20 @@ func (uc *transactionUseCase) SendUpcomingScheduleReminders() error {
 	}
 	return nil
 }
+
+// receiptTicketLines menampilkan kursi, tipe tiket, harga & syarat check-in per tiket
+func receiptTicketLines(tickets []domain.Ticket) string {
+	var lines strings.Builder
+	for _, t := range tickets {
+		typeName := t.TicketTypeCode
+		requirement := ""
+		if t.TicketType != nil {
+			typeName = t.TicketType.Name
+			if t.TicketType.Requirement != "" {
+				requirement = " <i>(" + t.TicketType.Requirement + ")</i>"
+			}
+		}
+		fmt.Fprintf(&lines, "<li>Seat %s%d - %s - Rp %.2f%s</li>", t.Seat.RowCode, t.Seat.SeatNumber, typeName, t.Price, requirement)
+	}
+	return lines.String()
+}
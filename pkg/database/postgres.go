@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"movie-app/internal/config"
+	"time"
 
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
@@ -16,11 +17,14 @@ import (
 
 func ConnectDB(cfg *config.Config) *gorm.DB {
 	// 1. Buat DSN untuk GORM
-	dsn := fmt.Sprintf("host=%s user=%s password=%s dbname=%s port=%s sslmode=disable",
+	// TimeZone=UTC: waktu disimpan & dibaca sebagai UTC, konversi ke jam lokal studio dilakukan di aplikasi
+	dsn := fmt.Sprintf("host=%s user=%s password=%s dbname=%s port=%s sslmode=disable TimeZone=UTC",
 		cfg.DBHost, cfg.DBUser, cfg.DBPassword, cfg.DBName, cfg.DBPort)
 
 	// 2. Koneksi GORM (Untuk aplikasi)
-	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{})
+	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{
+		NowFunc: func() time.Time { return time.Now().UTC() },
+	})
 	if err != nil {
 		log.Fatalf("Failed to connect to database: %v", err)
 	}
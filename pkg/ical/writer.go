@@ -0,0 +1,108 @@
+package ical
+
+import (
+	"bufio"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// Status event
+const (
+	StatusConfirmed = "CONFIRMED"
+	StatusCancelled = "CANCELLED"
+)
+
+// maxLineOctets adalah panjang maksimum 1 baris sebelum dilipat (RFC 5545 3.1)
+const maxLineOctets = 75
+
+// Calendar adalah VCALENDAR yang ditulis sebagai feed .ics
+type Calendar struct {
+	ProdID string // Mis. "-//Movie App//Showtimes//EN"
+	Name   string // X-WR-CALNAME, nama yang tampil di aplikasi kalender
+	Events []Event
+}
+
+// Write menulis cal sebagai iCalendar: baris diakhiri CRLF, dilipat per 75 oktet, waktu dalam UTC.
+func Write(w io.Writer, cal Calendar) error {
+	bw := bufio.NewWriter(w)
+	line := func(name, value string) {
+		writeFolded(bw, name+":"+value)
+	}
+
+	line("BEGIN", "VCALENDAR")
+	line("VERSION", "2.0")
+	line("PRODID", cal.ProdID)
+	line("CALSCALE", "GREGORIAN")
+	line("METHOD", "PUBLISH")
+	if cal.Name != "" {
+		line("X-WR-CALNAME", escape(cal.Name))
+	}
+
+	for _, e := range cal.Events {
+		stamp := e.Updated
+		if stamp.IsZero() {
+			stamp = time.Now()
+		}
+
+		line("BEGIN", "VEVENT")
+		line("UID", e.UID)
+		line("DTSTAMP", formatUTC(stamp))
+		line("LAST-MODIFIED", formatUTC(stamp))
+		if e.AllDay {
+			line("DTSTART;VALUE=DATE", e.Start.Format("20060102"))
+			if !e.End.IsZero() {
+				line("DTEND;VALUE=DATE", e.End.Format("20060102"))
+			}
+		} else {
+			line("DTSTART", formatUTC(e.Start))
+			if !e.End.IsZero() {
+				line("DTEND", formatUTC(e.End))
+			}
+		}
+		if e.Yearly {
+			line("RRULE", "FREQ=YEARLY")
+		}
+		line("SUMMARY", escape(e.Summary))
+		if e.Description != "" {
+			line("DESCRIPTION", escape(e.Description))
+		}
+		if e.Location != "" {
+			line("LOCATION", escape(e.Location))
+		}
+		if e.Status != "" {
+			line("STATUS", e.Status)
+		}
+		line("END", "VEVENT")
+	}
+
+	line("END", "VCALENDAR")
+	return bw.Flush()
+}
+
+func formatUTC(t time.Time) string {
+	return t.UTC().Format("20060102T150405Z")
+}
+
+// writeFolded memotong baris panjang tanpa memecah karakter UTF-8; baris lanjutan diawali 1 spasi
+func writeFolded(w *bufio.Writer, line string) {
+	limit := maxLineOctets
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+		w.WriteString(line[:cut])
+		w.WriteString("\r\n ")
+		line = line[cut:]
+		limit = maxLineOctets - 1 // Spasi awal baris lanjutan ikut dihitung
+	}
+	w.WriteString(line)
+	w.WriteString("\r\n")
+}
+
+func escape(value string) string {
+	value = strings.ReplaceAll(value, "\r\n", "\n")
+	return strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\n", `\n`).Replace(value)
+}
@@ -0,0 +1,25 @@
+package utils
+
+import (
+	"sync"
+	"time"
+)
+
+var locationCache sync.Map
+
+// LoadLocation memuat zona waktu IANA (mis. "Asia/Jakarta") dengan cache.
+// Nama kosong / tidak valid dikembalikan sebagai UTC.
+func LoadLocation(name string) *time.Location {
+	if name == "" {
+		return time.UTC
+	}
+	if loc, ok := locationCache.Load(name); ok {
+		return loc.(*time.Location)
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return time.UTC
+	}
+	locationCache.Store(name, loc)
+	return loc
+}